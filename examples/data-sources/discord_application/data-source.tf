@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+variable "guild_id" {
+  type    = string
+  default = "1452601985235816601" # Replace with your guild ID
+}
+
+data "discord_application" "bot" {
+  lifecycle {
+    postcondition {
+      condition     = contains(self.gateway_intents, "GUILD_MEMBERS")
+      error_message = "Enable the Server Members Intent for the bot in the Discord Developer Portal."
+    }
+  }
+}
+
+# Listing members needs the GUILD_MEMBERS intent, so read them only after checking it
+data "discord_members" "all" {
+  guild_id   = var.guild_id
+  depends_on = [data.discord_application.bot]
+}
+
+output "application_id" {
+  value = data.discord_application.bot.id
+}
@@ -0,0 +1,50 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+variable "guild_id" {
+  type    = string
+  default = "1452601985235816601" # Replace with your guild ID
+}
+
+data "discord_role" "moderators" {
+  name     = "Moderators"
+  guild_id = var.guild_id
+}
+
+# Who changed the Moderators role recently?
+data "discord_audit_log" "moderator_changes" {
+  guild_id    = var.guild_id
+  action_type = "ROLE_UPDATE"
+  target_id   = data.discord_role.moderators.id
+  limit       = 10
+}
+
+# old_value and new_value are JSON-encoded strings (a renamed role's new_value is "\"Moderators\""),
+# so decode them with jsondecode() to get the string, number, list or object Discord recorded
+output "moderator_changes" {
+  value = [
+    for entry in data.discord_audit_log.moderator_changes.entries : {
+      by      = entry.user_id
+      at      = entry.created_at
+      reason  = entry.reason
+      changes = { for change in entry.changes : change.key => jsondecode(change.new_value) if change.new_value != null }
+    }
+  ]
+}
+
+# The previous names of the role, decoded from the name changes
+output "previous_moderator_names" {
+  value = distinct(flatten([
+    for entry in data.discord_audit_log.moderator_changes.entries : [
+      for change in entry.changes : jsondecode(change.old_value) if change.key == "name" && change.old_value != null
+    ]
+  ]))
+}
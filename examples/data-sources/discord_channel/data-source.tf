@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+# Look up channel by ID
+data "discord_channel" "by_id" {
+  channel_id = "1452601985235816601" # Replace with your channel ID
+}
+
+# Look up channel by name
+data "discord_channel" "general" {
+  name     = "general"
+  guild_id = "1452601985235816601" # Replace with your guild ID
+}
+
+# Narrow a name lookup by type and category when the name is not unique
+data "discord_channel" "mod_log" {
+  name        = "mod-log"
+  type        = 0                     # Text channel
+  category_id = "1452601985235816601" # Replace with your category ID
+  guild_id    = "1452601985235816601" # Replace with your guild ID
+}
+
+output "general_channel_id" {
+  value = data.discord_channel.general.id
+}
+
+output "mod_log_overwrites" {
+  value = data.discord_channel.mod_log.permission_overwrites
+}
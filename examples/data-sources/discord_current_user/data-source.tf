@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+data "discord_current_user" "bot" {}
+
+# Make sure the bot can still post in a locked down channel
+resource "discord_channel_permission" "bot" {
+  channel_id   = "123456789012345678" # Replace with your channel ID
+  type         = "member"
+  overwrite_id = data.discord_current_user.bot.id
+  allow        = 3072 # VIEW_CHANNEL | SEND_MESSAGES
+  deny         = 0
+}
@@ -0,0 +1,46 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+variable "guild_id" {
+  type    = string
+  default = "1452601985235816601" # Replace with your guild ID
+}
+
+data "discord_role" "moderators" {
+  name     = "Moderators"
+  guild_id = var.guild_id
+}
+
+data "discord_channel" "mod_log" {
+  name     = "mod-log"
+  guild_id = var.guild_id
+}
+
+# What can a member holding only the Moderators role do in #mod-log?
+data "discord_effective_permissions" "moderators" {
+  channel_id = data.discord_channel.mod_log.id
+  role_ids   = [data.discord_role.moderators.id]
+}
+
+check "moderators_see_mod_log" {
+  assert {
+    condition     = contains(data.discord_effective_permissions.moderators.permission_names, "VIEW_CHANNEL")
+    error_message = "Moderators cannot see #mod-log."
+  }
+}
+
+# Why can or can't moderators see it?
+output "mod_log_view_channel" {
+  value = [
+    for explanation in data.discord_effective_permissions.moderators.explanations : explanation
+    if explanation.permission == "VIEW_CHANNEL"
+  ]
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+variable "partner_invite_code" {
+  type    = string
+  default = "discord-developers" # Replace with a partner server's invite code
+}
+
+# Resolve a partner server's invite, even though the bot is not a member of it
+data "discord_invite" "partner" {
+  code = var.partner_invite_code
+}
+
+output "partner_server" {
+  value = {
+    id      = data.discord_invite.partner.guild_id
+    name    = data.discord_invite.partner.guild_name
+    channel = data.discord_invite.partner.channel_name
+    members = data.discord_invite.partner.approximate_member_count
+    online  = data.discord_invite.partner.approximate_presence_count
+    expires = data.discord_invite.partner.expires_at
+  }
+}
@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+variable "guild_id" {
+  type    = string
+  default = "1452601985235816601" # Replace with your guild ID
+}
+
+# Invites that never expire, which should be rotated
+data "discord_invites" "unlimited" {
+  guild_id  = var.guild_id
+  unlimited = true
+}
+
+# Expired or used up invites that Discord has not cleaned up yet
+data "discord_invites" "expired" {
+  guild_id = var.guild_id
+  expired  = true
+}
+
+output "invite_usage" {
+  value = {
+    for invite in data.discord_invites.unlimited.invites : invite.code => {
+      inviter = invite.inviter_id
+      uses    = invite.uses
+      created = invite.created_at
+    }
+  }
+}
+
+output "expired_invites" {
+  value = data.discord_invites.expired.invites[*].code
+}
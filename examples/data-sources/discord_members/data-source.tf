@@ -36,3 +36,27 @@ output "members_with_everyone_role" {
     if contains(member.roles, "1452601985235816601") # Replace with the role ID
   ]
 }
+
+# Get the human members holding both the Staff and Moderator roles
+data "discord_members" "staff_moderators" {
+  guild_id   = "1452601985235816601"                        # Replace with your guild ID
+  role_ids   = ["111111111111111111", "222222222222222222"] # Replace with role IDs
+  role_match = "all"
+  bot        = false
+}
+
+# Get up to 50 members whose username or nickname starts with "alex" and who joined in 2024
+data "discord_members" "alex" {
+  guild_id     = "1452601985235816601" # Replace with your guild ID
+  query        = "alex"
+  joined_after = "2024-01-01T00:00:00Z"
+  limit        = 50
+}
+
+output "alex_total" {
+  value = data.discord_members.alex.total_count
+}
+
+output "alex_truncated" {
+  value = data.discord_members.alex.truncated
+}
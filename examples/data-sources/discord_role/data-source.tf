@@ -21,6 +21,12 @@ data "discord_role" "by_name" {
   guild_id = "1452601985235816601" # Replace with your guild ID
 }
 
+# Look up the one role whose name matches a pattern
+data "discord_role" "by_name_regex" {
+  name_regex = "^Moderators?$"
+  guild_id   = "1452601985235816601" # Replace with your guild ID
+}
+
 output "role_by_id" {
   value = data.discord_role.by_id
 }
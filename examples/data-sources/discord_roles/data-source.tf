@@ -20,3 +20,23 @@ output "role_names" {
 output "role_ids" {
   value = [for role in data.discord_roles.all.roles : role.id]
 }
+
+# Roles managed by an integration, a bot or server boosts
+data "discord_roles" "managed" {
+  guild_id = "1452601985235816601" # Replace with your guild ID
+  managed  = true
+}
+
+# Roles that grant ADMINISTRATOR, for a security audit
+data "discord_roles" "admins" {
+  guild_id       = "1452601985235816601" # Replace with your guild ID
+  has_permission = "ADMINISTRATOR"
+}
+
+output "integration_roles" {
+  value = [for role in data.discord_roles.managed.roles : role.name if role.integration_id != null]
+}
+
+output "admin_roles" {
+  value = [for role in data.discord_roles.admins.roles : role.name]
+}
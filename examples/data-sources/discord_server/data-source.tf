@@ -10,9 +10,26 @@ terraform {
 provider "discord" {}
 
 data "discord_server" "example" {
-  server_id = "1452601985235816601" # Replace with your server ID
+  server_id = "1452601985235816601" # Replace with your server ID, or omit to use the provider's guild_id
 }
 
 output "server" {
   value = data.discord_server.example
 }
+
+data "discord_emojis" "all" {
+  guild_id = data.discord_server.example.id
+}
+
+# Boost-tier dependent limits, for example to decide whether more animated emojis fit
+output "boost_tier" {
+  value = data.discord_server.example.premium_tier
+}
+
+output "voice_bitrate_limit" {
+  value = data.discord_server.example.bitrate_limit
+}
+
+output "animated_emoji_slots_left" {
+  value = data.discord_server.example.emoji_limit - length([for e in data.discord_emojis.all.emojis : e if e.animated])
+}
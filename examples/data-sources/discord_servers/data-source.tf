@@ -11,6 +11,17 @@ provider "discord" {}
 
 data "discord_servers" "all" {}
 
+# Community servers in the production fleet, with approximate member counts
+data "discord_servers" "production" {
+  name_regex  = "^prod-"
+  features    = ["COMMUNITY"]
+  with_counts = true
+}
+
 output "servers" {
   value = data.discord_servers.all.servers
 }
+
+output "production_member_counts" {
+  value = { for server in data.discord_servers.production.servers : server.name => server.approximate_member_count }
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+# Look up a user who has not joined the server yet
+data "discord_user" "contractor" {
+  user_id = "123456789012345678" # Replace with the user's ID
+}
+
+output "contractor" {
+  value = {
+    username   = data.discord_user.contractor.username
+    avatar_url = data.discord_user.contractor.avatar_url
+    bot        = data.discord_user.contractor.bot
+  }
+}
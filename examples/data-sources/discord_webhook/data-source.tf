@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+variable "channel_id" {
+  type    = string
+  default = "1452601985235816602" # Replace with your channel ID
+}
+
+# Look up a webhook by name within a channel
+data "discord_webhook" "deploys" {
+  channel_id = var.channel_id
+  name       = "deploys"
+}
+
+# The URL is only known when the provider's bot created the webhook
+output "deploys_webhook_url" {
+  value     = data.discord_webhook.deploys.url
+  sensitive = true
+}
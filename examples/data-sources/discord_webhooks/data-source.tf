@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+variable "guild_id" {
+  type    = string
+  default = "1452601985235816601" # Replace with your guild ID
+}
+
+# Every webhook in the guild
+data "discord_webhooks" "all" {
+  guild_id = var.guild_id
+}
+
+# Webhooks created by other bots and integrations, whose tokens are not returned
+output "foreign_webhooks" {
+  value = [
+    for webhook in data.discord_webhooks.all.webhooks : {
+      name       = webhook.name
+      channel_id = webhook.channel_id
+      creator_id = webhook.creator_id
+    } if webhook.token == null
+  ]
+}
+
+# Channels that follow announcement channels
+output "followed_channels" {
+  value = {
+    for webhook in data.discord_webhooks.all.webhooks : webhook.channel_id => "${webhook.source_guild_name} #${webhook.source_channel_name}"
+    if webhook.type == 2
+  }
+}
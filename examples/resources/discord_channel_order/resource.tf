@@ -0,0 +1,56 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+resource "discord_category" "info" {
+  name     = "Info"
+  guild_id = "123456789012345678" # Replace with your guild ID
+}
+
+resource "discord_category" "chat" {
+  name     = "Chat"
+  guild_id = "123456789012345678" # Replace with your guild ID
+}
+
+resource "discord_channel" "rules" {
+  name     = "rules"
+  type     = "text"
+  guild_id = "123456789012345678" # Replace with your guild ID
+}
+
+resource "discord_channel" "general" {
+  name     = "general"
+  type     = "text"
+  guild_id = "123456789012345678" # Replace with your guild ID
+}
+
+resource "discord_channel" "voice" {
+  name     = "Voice"
+  type     = "voice"
+  guild_id = "123456789012345678" # Replace with your guild ID
+}
+
+# Lay out categories and their channels from top to bottom in one request.
+# Channels are moved into the category they are listed under.
+resource "discord_channel_order" "main" {
+  guild_id = "123456789012345678" # Replace with your guild ID
+
+  categories = [
+    {
+      category_id = discord_category.info.id
+      channel_ids = [discord_channel.rules.id]
+    },
+    {
+      category_id      = discord_category.chat.id
+      channel_ids      = [discord_channel.general.id, discord_channel.voice.id]
+      lock_permissions = true
+    },
+  ]
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+resource "discord_role" "bots" {
+  name     = "Bots"
+  guild_id = "1452601985235816601" # Replace with your guild ID
+}
+
+resource "discord_role" "announcer" {
+  name     = "Announcer"
+  guild_id = "1452601985235816601" # Replace with your guild ID
+}
+
+# Give the service bot exactly these roles.
+# Any other (non-managed) role it holds is removed on apply.
+resource "discord_member_roles" "service_bot" {
+  guild_id = "1452601985235816601" # Replace with your guild ID
+  user_id  = "1452597490959515802" # Replace with the member's user ID
+  role_ids = [
+    discord_role.bots.id,
+    discord_role.announcer.id,
+  ]
+}
@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+# Create a role first
+resource "discord_role" "staff" {
+  name     = "Staff"
+  guild_id = "1452601985235816601" # Replace with your guild ID
+}
+
+# Make these users the only holders of the role.
+# Anyone granted the role by hand is removed on the next apply.
+resource "discord_role_members" "staff" {
+  guild_id = "1452601985235816601" # Replace with your guild ID
+  role_id  = discord_role.staff.id
+  user_ids = [
+    "1452597490959515802", # Replace with user IDs in your guild
+    "111111111111111111",
+  ]
+}
+
+# Adopt an existing role gradually: only the listed users are managed,
+# other holders of the role are left alone.
+resource "discord_role_members" "moderators" {
+  guild_id = "1452601985235816601" # Replace with your guild ID
+  role_id  = "987654321098765432"  # Replace with an existing role ID
+  mode     = "additive"
+  user_ids = [
+    "1452597490959515802",
+  ]
+}
+
+output "staff_member_count" {
+  value = length(discord_role_members.staff.user_ids)
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    discord = {
+      source  = "tfstack/discord"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "discord" {}
+
+resource "discord_role" "admin" {
+  name     = "Admin"
+  guild_id = "1452601985235816601" # Replace with your guild ID
+}
+
+resource "discord_role" "moderator" {
+  name     = "Moderator"
+  guild_id = "1452601985235816601" # Replace with your guild ID
+}
+
+resource "discord_role" "member" {
+  name     = "Member"
+  guild_id = "1452601985235816601" # Replace with your guild ID
+}
+
+# Order the roles from the top of the hierarchy to the bottom in one request.
+# Roles not listed here keep their place between their neighbours.
+resource "discord_role_order" "main" {
+  guild_id = "1452601985235816601" # Replace with your guild ID
+  role_ids = [
+    discord_role.admin.id,
+    discord_role.moderator.id,
+    discord_role.member.id,
+  ]
+}
@@ -0,0 +1,926 @@
+package fakediscord
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// communityChannelTypes are the channel types Discord only allows in Community guilds.
+var communityChannelTypes = map[discordgo.ChannelType]struct{}{
+	discordgo.ChannelTypeGuildNews:       {},
+	discordgo.ChannelTypeGuildStageVoice: {},
+	discordgo.ChannelTypeGuildForum:      {},
+	discordgo.ChannelTypeGuildDirectory:  {},
+}
+
+// AddChannel creates a channel in a guild at the bottom of its parent.
+func (s *Server) AddChannel(guildID, name string, channelType discordgo.ChannelType, parentID string) *discordgo.Channel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.guilds[guildID]; !ok {
+		return nil
+	}
+	channel := s.addChannelLocked(&discordgo.Channel{GuildID: guildID, Name: name, Type: channelType, ParentID: parentID})
+	copied := *channel
+	return &copied
+}
+
+// addChannelLocked stores a channel with a new ID below its siblings. The caller must hold s.mu.
+func (s *Server) addChannelLocked(channel *discordgo.Channel) *discordgo.Channel {
+	channel.ID = s.newID()
+	if channel.PermissionOverwrites == nil {
+		channel.PermissionOverwrites = []*discordgo.PermissionOverwrite{}
+	}
+
+	position := 0
+	for _, sibling := range s.channels {
+		if sibling.GuildID == channel.GuildID && sibling.ParentID == channel.ParentID && sibling.Position >= position {
+			position = sibling.Position + 1
+		}
+	}
+	channel.Position = position
+
+	s.channels[channel.ID] = channel
+	return channel
+}
+
+// Channel returns a copy of a stored channel.
+func (s *Server) Channel(channelID string) (*discordgo.Channel, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	channel, ok := s.channels[channelID]
+	if !ok {
+		return nil, false
+	}
+	copied := *channel
+	return &copied, true
+}
+
+// Message returns a copy of a stored message.
+func (s *Server) Message(channelID, messageID string) (*discordgo.Message, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	message, ok := s.messages[channelID][messageID]
+	if !ok {
+		return nil, false
+	}
+	copied := *message
+	return &copied, true
+}
+
+// Webhook returns a copy of a stored webhook.
+func (s *Server) Webhook(webhookID string) (*discordgo.Webhook, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	webhook, ok := s.webhooks[webhookID]
+	if !ok {
+		return nil, false
+	}
+	copied := *webhook
+	return &copied, true
+}
+
+// Invite returns a copy of a stored invite.
+func (s *Server) Invite(code string) (*discordgo.Invite, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	invite, ok := s.invites[code]
+	if !ok {
+		return nil, false
+	}
+	copied := *invite
+	return &copied, true
+}
+
+// AddInvite stores an invite created outside of Terraform, for example by a moderator, for a channel. The
+// inviter defaults to the bot user, the creation time to now and the code to a generated one. Uses can be set
+// to simulate an invite that has been used.
+func (s *Server) AddInvite(channelID string, invite discordgo.Invite) *discordgo.Invite {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	channel, ok := s.channels[channelID]
+	if !ok {
+		return nil
+	}
+	if invite.Code == "" {
+		invite.Code = inviteCode(s.newID())
+	}
+	if invite.Inviter == nil {
+		invite.Inviter = s.BotUser
+	}
+	if invite.CreatedAt.IsZero() {
+		invite.CreatedAt = now()
+	}
+	invite.Guild = &discordgo.Guild{ID: channel.GuildID}
+	invite.Channel = &discordgo.Channel{ID: channel.ID, Name: channel.Name, Type: channel.Type}
+	s.invites[invite.Code] = &invite
+	return s.inviteResponse(&invite, false)
+}
+
+// AddWebhook stores a webhook created outside of Terraform, for example by an integration, in a channel. Only
+// the name, avatar, creator and application ID of webhook are used. The webhook gets a token.
+func (s *Server) AddWebhook(channelID string, webhook discordgo.Webhook) *discordgo.Webhook {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	channel, ok := s.channels[channelID]
+	if !ok {
+		return nil
+	}
+	webhook.ID = s.newID()
+	webhook.Type = discordgo.WebhookTypeIncoming
+	webhook.GuildID, webhook.ChannelID = channel.GuildID, channel.ID
+	webhook.Token = "token-" + webhook.ID
+	s.webhooks[webhook.ID] = &webhook
+	copied := webhook
+	return &copied
+}
+
+// AddFollowerWebhook stores a channel follower webhook in a channel that republishes the messages of
+// sourceChannelID, as Discord creates when a channel follows an announcement channel. Follower webhooks have no
+// token.
+func (s *Server) AddFollowerWebhook(channelID, sourceChannelID, name string) *discordgo.Webhook {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	channel, ok := s.channels[channelID]
+	if !ok {
+		return nil
+	}
+	if _, ok := s.channels[sourceChannelID]; !ok {
+		return nil
+	}
+	webhook := &discordgo.Webhook{
+		ID:        s.newID(),
+		Type:      discordgo.WebhookTypeChannelFollower,
+		GuildID:   channel.GuildID,
+		ChannelID: channel.ID,
+		User:      s.BotUser,
+		Name:      name,
+	}
+	s.webhooks[webhook.ID] = webhook
+	s.webhookSources[webhook.ID] = sourceChannelID
+	copied := *webhook
+	return &copied
+}
+
+// DeleteChannel deletes a channel outside of the API, to simulate drift.
+func (s *Server) DeleteChannel(channelID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deleteChannelLocked(channelID)
+}
+
+// MoveChannel moves a channel to another category, or to the top level when parentID is empty, outside of
+// the API, to simulate drift.
+func (s *Server) MoveChannel(channelID, parentID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if channel, ok := s.channels[channelID]; ok {
+		channel.ParentID = parentID
+	}
+}
+
+// EditChannel applies edit to a stored channel outside of the API, to set up fields the API cannot or to
+// simulate drift.
+func (s *Server) EditChannel(channelID string, edit func(*discordgo.Channel)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if channel, ok := s.channels[channelID]; ok {
+		edit(channel)
+	}
+}
+
+// DeleteOverwrite removes a permission overwrite outside of the API, to simulate drift.
+func (s *Server) DeleteOverwrite(channelID, overwriteID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	channel, ok := s.channels[channelID]
+	if !ok {
+		return
+	}
+	overwrites := make([]*discordgo.PermissionOverwrite, 0, len(channel.PermissionOverwrites))
+	for _, existing := range channel.PermissionOverwrites {
+		if existing.ID != overwriteID {
+			overwrites = append(overwrites, existing)
+		}
+	}
+	channel.PermissionOverwrites = overwrites
+}
+
+// DeleteMessage deletes a message outside of the API, to simulate drift.
+func (s *Server) DeleteMessage(channelID, messageID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.messages[channelID], messageID)
+}
+
+// DeleteWebhook deletes a webhook outside of the API, to simulate drift.
+func (s *Server) DeleteWebhook(webhookID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.webhooks, webhookID)
+}
+
+// DeleteInvite deletes an invite outside of the API, to simulate drift.
+func (s *Server) DeleteInvite(code string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.invites, code)
+}
+
+// deleteChannelLocked removes a channel with its messages, webhooks and invites. Channels in a deleted
+// category move to the top level, as in Discord. The caller must hold s.mu.
+func (s *Server) deleteChannelLocked(channelID string) {
+	delete(s.channels, channelID)
+	delete(s.messages, channelID)
+	for _, channel := range s.channels {
+		if channel.ParentID == channelID {
+			channel.ParentID = ""
+		}
+	}
+	for id, webhook := range s.webhooks {
+		if webhook.ChannelID == channelID {
+			delete(s.webhooks, id)
+		}
+	}
+	for code, invite := range s.invites {
+		if invite.Channel != nil && invite.Channel.ID == channelID {
+			delete(s.invites, code)
+		}
+	}
+}
+
+// listChannels handles GET /guilds/{guild}/channels.
+func (s *Server) listChannels(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+
+	channels := make([]*discordgo.Channel, 0)
+	for _, channel := range s.channels {
+		if channel.GuildID == g.guild.ID {
+			channels = append(channels, channel)
+		}
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		if channels[i].Position != channels[j].Position {
+			return channels[i].Position < channels[j].Position
+		}
+		return snowflakeLess(channels[i].ID, channels[j].ID)
+	})
+	writeJSON(w, http.StatusOK, channels)
+}
+
+// validParent reports whether parentID is empty or a category in the guild.
+func (s *Server) validParent(guildID, parentID string) bool {
+	if parentID == "" {
+		return true
+	}
+	parent, ok := s.channels[parentID]
+	return ok && parent.GuildID == guildID && parent.Type == discordgo.ChannelTypeGuildCategory
+}
+
+// hasFeature reports whether the guild has a feature.
+func (g *guildState) hasFeature(feature discordgo.GuildFeature) bool {
+	for _, f := range g.guild.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// createChannel handles POST /guilds/{guild}/channels.
+func (s *Server) createChannel(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+
+	var data discordgo.GuildChannelCreateData
+	if !decode(w, r, &data) {
+		return
+	}
+	if data.Name == "" || len(data.Name) > 100 {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	if _, ok := communityChannelTypes[data.Type]; ok && !g.hasFeature(discordgo.GuildFeatureCommunity) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body: this channel type requires the COMMUNITY guild feature")
+		return
+	}
+	if (data.Type == discordgo.ChannelTypeGuildCategory && data.ParentID != "") || !s.validParent(g.guild.ID, data.ParentID) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+
+	channel := s.addChannelLocked(&discordgo.Channel{
+		GuildID:              g.guild.ID,
+		Name:                 data.Name,
+		Type:                 data.Type,
+		Topic:                data.Topic,
+		Bitrate:              data.Bitrate,
+		UserLimit:            data.UserLimit,
+		RateLimitPerUser:     data.RateLimitPerUser,
+		NSFW:                 data.NSFW,
+		ParentID:             data.ParentID,
+		PermissionOverwrites: data.PermissionOverwrites,
+	})
+	if data.Position != 0 {
+		channel.Position = data.Position
+	}
+	writeJSON(w, http.StatusCreated, channel)
+}
+
+// reorderChannels handles PATCH /guilds/{guild}/channels with a list of positions and optional parents.
+func (s *Server) reorderChannels(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+
+	var updates []map[string]json.RawMessage
+	if !decode(w, r, &updates) {
+		return
+	}
+
+	type change struct {
+		channel   *discordgo.Channel
+		position  *int
+		parentID  *string
+		lockPerms bool
+	}
+	changes := make([]change, 0, len(updates))
+
+	for _, update := range updates {
+		var id string
+		if err := json.Unmarshal(update["id"], &id); err != nil {
+			writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+			return
+		}
+		channel, ok := s.channels[id]
+		if !ok || channel.GuildID != g.guild.ID {
+			writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownChannel, "Unknown Channel")
+			return
+		}
+
+		c := change{channel: channel}
+		if raw, ok := update["position"]; ok {
+			var position int
+			if err := json.Unmarshal(raw, &position); err != nil {
+				writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+				return
+			}
+			c.position = &position
+		}
+		if raw, ok := update["parent_id"]; ok {
+			var parentID *string
+			if err := json.Unmarshal(raw, &parentID); err != nil {
+				writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+				return
+			}
+			if parentID == nil {
+				parentID = new(string)
+			}
+			if (channel.Type == discordgo.ChannelTypeGuildCategory && *parentID != "") || !s.validParent(g.guild.ID, *parentID) {
+				writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+				return
+			}
+			c.parentID = parentID
+		}
+		if raw, ok := update["lock_permissions"]; ok {
+			_ = json.Unmarshal(raw, &c.lockPerms)
+		}
+		changes = append(changes, c)
+	}
+
+	// The request is applied as a whole once every entry is valid
+	for _, c := range changes {
+		if c.position != nil {
+			c.channel.Position = *c.position
+		}
+		if c.parentID != nil {
+			c.channel.ParentID = *c.parentID
+		}
+		if c.lockPerms && c.channel.ParentID != "" {
+			parent := s.channels[c.channel.ParentID]
+			c.channel.PermissionOverwrites = append([]*discordgo.PermissionOverwrite{}, parent.PermissionOverwrites...)
+		}
+	}
+
+	writeNoContent(w)
+}
+
+// getChannel handles GET /channels/{channel}.
+func (s *Server) getChannel(w http.ResponseWriter, r *http.Request) {
+	channel := s.channelOr404(w, r)
+	if channel == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, channel)
+}
+
+// editChannel handles PATCH /channels/{channel}.
+func (s *Server) editChannel(w http.ResponseWriter, r *http.Request) {
+	channel := s.channelOr404(w, r)
+	if channel == nil {
+		return
+	}
+
+	before := *channel
+	if !patch(w, r, channel) {
+		return
+	}
+	channel.ID, channel.GuildID, channel.Type = before.ID, before.GuildID, before.Type
+	if channel.PermissionOverwrites == nil {
+		channel.PermissionOverwrites = []*discordgo.PermissionOverwrite{}
+	}
+
+	if channel.Name == "" || !s.validParent(channel.GuildID, channel.ParentID) ||
+		(channel.Type == discordgo.ChannelTypeGuildCategory && channel.ParentID != "") {
+		*channel = before
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, channel)
+}
+
+// deleteChannel handles DELETE /channels/{channel}.
+func (s *Server) deleteChannel(w http.ResponseWriter, r *http.Request) {
+	channel := s.channelOr404(w, r)
+	if channel == nil {
+		return
+	}
+	s.deleteChannelLocked(channel.ID)
+	writeJSON(w, http.StatusOK, channel)
+}
+
+// setOverwrite handles PUT /channels/{channel}/permissions/{overwrite}.
+func (s *Server) setOverwrite(w http.ResponseWriter, r *http.Request) {
+	channel := s.channelOr404(w, r)
+	if channel == nil {
+		return
+	}
+
+	var overwrite discordgo.PermissionOverwrite
+	if !decode(w, r, &overwrite) {
+		return
+	}
+	overwrite.ID = r.PathValue("overwrite")
+
+	overwrites := make([]*discordgo.PermissionOverwrite, 0, len(channel.PermissionOverwrites)+1)
+	for _, existing := range channel.PermissionOverwrites {
+		if existing.ID != overwrite.ID {
+			overwrites = append(overwrites, existing)
+		}
+	}
+	channel.PermissionOverwrites = append(overwrites, &overwrite)
+	writeNoContent(w)
+}
+
+// deleteOverwrite handles DELETE /channels/{channel}/permissions/{overwrite}.
+func (s *Server) deleteOverwrite(w http.ResponseWriter, r *http.Request) {
+	channel := s.channelOr404(w, r)
+	if channel == nil {
+		return
+	}
+
+	overwrites := make([]*discordgo.PermissionOverwrite, 0, len(channel.PermissionOverwrites))
+	for _, existing := range channel.PermissionOverwrites {
+		if existing.ID != r.PathValue("overwrite") {
+			overwrites = append(overwrites, existing)
+		}
+	}
+	channel.PermissionOverwrites = overwrites
+	writeNoContent(w)
+}
+
+// messageOr404 returns the message named in the path, or answers Unknown Message.
+func (s *Server) messageOr404(w http.ResponseWriter, r *http.Request) *discordgo.Message {
+	message, ok := s.messages[r.PathValue("channel")][r.PathValue("message")]
+	if !ok {
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownMessage, "Unknown Message")
+		return nil
+	}
+	return message
+}
+
+// createMessage handles POST /channels/{channel}/messages, as JSON or as multipart with payload_json.
+func (s *Server) createMessage(w http.ResponseWriter, r *http.Request) {
+	channel := s.channelOr404(w, r)
+	if channel == nil {
+		return
+	}
+
+	var data discordgo.MessageSend
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "multipart/form-data" {
+		if err := r.ParseMultipartForm(8 << 20); err != nil ||
+			json.Unmarshal([]byte(r.FormValue("payload_json")), &data) != nil {
+			writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+			return
+		}
+	} else if !decode(w, r, &data) {
+		return
+	}
+
+	if data.Content == "" && len(data.Embeds) == 0 && len(data.Files) == 0 {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeCannotSendEmptyMessage, "Cannot send an empty message")
+		return
+	}
+
+	message := &discordgo.Message{
+		ID:        s.newID(),
+		ChannelID: channel.ID,
+		GuildID:   channel.GuildID,
+		Content:   data.Content,
+		Embeds:    data.Embeds,
+		TTS:       data.TTS,
+		Author:    s.BotUser,
+		Timestamp: now(),
+	}
+	if message.Embeds == nil {
+		message.Embeds = []*discordgo.MessageEmbed{}
+	}
+
+	if s.messages[channel.ID] == nil {
+		s.messages[channel.ID] = make(map[string]*discordgo.Message)
+	}
+	s.messages[channel.ID][message.ID] = message
+	writeJSON(w, http.StatusOK, message)
+}
+
+// getMessage handles GET /channels/{channel}/messages/{message}.
+func (s *Server) getMessage(w http.ResponseWriter, r *http.Request) {
+	if s.channelOr404(w, r) == nil {
+		return
+	}
+	message := s.messageOr404(w, r)
+	if message == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, message)
+}
+
+// editMessage handles PATCH /channels/{channel}/messages/{message}.
+func (s *Server) editMessage(w http.ResponseWriter, r *http.Request) {
+	if s.channelOr404(w, r) == nil {
+		return
+	}
+	message := s.messageOr404(w, r)
+	if message == nil {
+		return
+	}
+
+	var data discordgo.MessageEdit
+	if !decode(w, r, &data) {
+		return
+	}
+	if data.Content != nil {
+		message.Content = *data.Content
+	}
+	if data.Embeds != nil {
+		message.Embeds = *data.Embeds
+	}
+	edited := now()
+	message.EditedTimestamp = &edited
+
+	writeJSON(w, http.StatusOK, message)
+}
+
+// deleteMessage handles DELETE /channels/{channel}/messages/{message}.
+func (s *Server) deleteMessage(w http.ResponseWriter, r *http.Request) {
+	if s.channelOr404(w, r) == nil {
+		return
+	}
+	message := s.messageOr404(w, r)
+	if message == nil {
+		return
+	}
+	delete(s.messages[message.ChannelID], message.ID)
+	writeNoContent(w)
+}
+
+// inviteCode returns a short invite code derived from a snowflake.
+func inviteCode(id string) string {
+	n, _ := strconv.ParseUint(id, 10, 64)
+	return strconv.FormatUint(n, 36)
+}
+
+// inviteResponse returns the invite as Discord returns it, with the guild and channel as partial objects.
+func (s *Server) inviteResponse(invite *discordgo.Invite, withCounts bool) *discordgo.Invite {
+	out := *invite
+	if g, ok := s.guilds[invite.Guild.ID]; ok {
+		out.Guild = &discordgo.Guild{
+			ID:                g.guild.ID,
+			Name:              g.guild.Name,
+			Icon:              g.guild.Icon,
+			Description:       g.guild.Description,
+			Features:          g.guild.Features,
+			VerificationLevel: g.guild.VerificationLevel,
+		}
+		if withCounts {
+			out.ApproximateMemberCount, out.ApproximatePresenceCount = g.approximateCounts()
+		}
+	}
+	if invite.MaxAge > 0 {
+		expires := invite.CreatedAt.Add(time.Duration(invite.MaxAge) * time.Second)
+		out.ExpiresAt = &expires
+	}
+	return &out
+}
+
+// inviteExpired reports whether an invite has passed its max age or used up its max uses. Discord no longer
+// resolves such invites by code, but may still list them until they are cleaned up.
+func inviteExpired(invite *discordgo.Invite) bool {
+	if invite.MaxAge > 0 && !now().Before(invite.CreatedAt.Add(time.Duration(invite.MaxAge)*time.Second)) {
+		return true
+	}
+	return invite.MaxUses > 0 && invite.Uses >= invite.MaxUses
+}
+
+// createInvite handles POST /channels/{channel}/invites. Without unique, an existing invite with the same
+// settings is returned, as Discord does.
+func (s *Server) createInvite(w http.ResponseWriter, r *http.Request) {
+	channel := s.channelOr404(w, r)
+	if channel == nil {
+		return
+	}
+
+	var data struct {
+		MaxAge    *int `json:"max_age"`
+		MaxUses   int  `json:"max_uses"`
+		Temporary bool `json:"temporary"`
+		Unique    bool `json:"unique"`
+	}
+	if !decode(w, r, &data) {
+		return
+	}
+	maxAge := 86400
+	if data.MaxAge != nil {
+		maxAge = *data.MaxAge
+	}
+	if maxAge < 0 || maxAge > 604800 || data.MaxUses < 0 || data.MaxUses > 100 {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+
+	if !data.Unique {
+		for _, invite := range s.invites {
+			if invite.Channel.ID == channel.ID && invite.MaxAge == maxAge && invite.MaxUses == data.MaxUses &&
+				invite.Temporary == data.Temporary && invite.Inviter.ID == s.BotUser.ID {
+				writeJSON(w, http.StatusOK, s.inviteResponse(invite, false))
+				return
+			}
+		}
+	}
+
+	invite := &discordgo.Invite{
+		Code:      inviteCode(s.newID()),
+		Guild:     &discordgo.Guild{ID: channel.GuildID},
+		Channel:   &discordgo.Channel{ID: channel.ID, Name: channel.Name, Type: channel.Type},
+		Inviter:   s.BotUser,
+		CreatedAt: now(),
+		MaxAge:    maxAge,
+		MaxUses:   data.MaxUses,
+		Temporary: data.Temporary,
+		Unique:    data.Unique,
+	}
+	s.invites[invite.Code] = invite
+	writeJSON(w, http.StatusOK, s.inviteResponse(invite, false))
+}
+
+// getInvite handles GET /invites/{code}. Expired invites are unknown, as in Discord.
+func (s *Server) getInvite(w http.ResponseWriter, r *http.Request) {
+	invite, ok := s.invites[r.PathValue("code")]
+	if !ok || inviteExpired(invite) {
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownInvite, "Unknown Invite")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.inviteResponse(invite, r.URL.Query().Get("with_counts") == "true"))
+}
+
+// deleteInvite handles DELETE /invites/{code}.
+func (s *Server) deleteInvite(w http.ResponseWriter, r *http.Request) {
+	invite, ok := s.invites[r.PathValue("code")]
+	if !ok {
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownInvite, "Unknown Invite")
+		return
+	}
+	delete(s.invites, invite.Code)
+	writeJSON(w, http.StatusOK, s.inviteResponse(invite, false))
+}
+
+// listInvites returns the invites matching keep, ordered by code.
+func (s *Server) listInvites(keep func(*discordgo.Invite) bool) []*discordgo.Invite {
+	invites := make([]*discordgo.Invite, 0)
+	for _, invite := range s.invites {
+		if keep(invite) {
+			invites = append(invites, s.inviteResponse(invite, false))
+		}
+	}
+	sort.Slice(invites, func(i, j int) bool { return invites[i].Code < invites[j].Code })
+	return invites
+}
+
+// listChannelInvites handles GET /channels/{channel}/invites.
+func (s *Server) listChannelInvites(w http.ResponseWriter, r *http.Request) {
+	channel := s.channelOr404(w, r)
+	if channel == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.listInvites(func(invite *discordgo.Invite) bool {
+		return invite.Channel.ID == channel.ID
+	}))
+}
+
+// listGuildInvites handles GET /guilds/{guild}/invites.
+func (s *Server) listGuildInvites(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.listInvites(func(invite *discordgo.Invite) bool {
+		return invite.Guild.ID == g.guild.ID
+	}))
+}
+
+// webhookOr404 returns the webhook named in the path, or answers Unknown Webhook.
+func (s *Server) webhookOr404(w http.ResponseWriter, r *http.Request) *discordgo.Webhook {
+	webhook, ok := s.webhooks[r.PathValue("webhook")]
+	if !ok {
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownWebhook, "Unknown Webhook")
+		return nil
+	}
+	return webhook
+}
+
+// validWebhookName reports whether Discord accepts a webhook name.
+func validWebhookName(name string) bool {
+	lower := strings.ToLower(name)
+	return name != "" && len(name) <= 80 && !strings.Contains(lower, "clyde") && !strings.Contains(lower, "discord")
+}
+
+// webhookResponse returns the webhook as Discord returns it, with the source guild and channel of a channel
+// follower webhook as partial objects.
+func (s *Server) webhookResponse(webhook *discordgo.Webhook) any {
+	out := struct {
+		*discordgo.Webhook
+		SourceGuild   *discordgo.Guild   `json:"source_guild,omitempty"`
+		SourceChannel *discordgo.Channel `json:"source_channel,omitempty"`
+	}{Webhook: webhook}
+
+	if source, ok := s.channels[s.webhookSources[webhook.ID]]; ok {
+		out.SourceChannel = &discordgo.Channel{ID: source.ID, Name: source.Name}
+		if g, ok := s.guilds[source.GuildID]; ok {
+			out.SourceGuild = &discordgo.Guild{ID: g.guild.ID, Name: g.guild.Name, Icon: g.guild.Icon}
+		}
+	}
+	return out
+}
+
+// webhookResponses returns the webhooks as Discord returns them.
+func (s *Server) webhookResponses(webhooks []*discordgo.Webhook) []any {
+	out := make([]any, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		out = append(out, s.webhookResponse(webhook))
+	}
+	return out
+}
+
+// createWebhook handles POST /channels/{channel}/webhooks.
+func (s *Server) createWebhook(w http.ResponseWriter, r *http.Request) {
+	channel := s.channelOr404(w, r)
+	if channel == nil {
+		return
+	}
+
+	var data struct {
+		Name   string `json:"name"`
+		Avatar string `json:"avatar"`
+	}
+	if !decode(w, r, &data) {
+		return
+	}
+	if !validWebhookName(data.Name) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+
+	id := s.newID()
+	webhook := &discordgo.Webhook{
+		ID:        id,
+		Type:      discordgo.WebhookTypeIncoming,
+		GuildID:   channel.GuildID,
+		ChannelID: channel.ID,
+		User:      s.BotUser,
+		Name:      data.Name,
+		Avatar:    data.Avatar,
+		Token:     "token-" + id,
+	}
+	s.webhooks[id] = webhook
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+// getWebhook handles GET /webhooks/{webhook}.
+func (s *Server) getWebhook(w http.ResponseWriter, r *http.Request) {
+	webhook := s.webhookOr404(w, r)
+	if webhook == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.webhookResponse(webhook))
+}
+
+// editWebhook handles PATCH /webhooks/{webhook}.
+func (s *Server) editWebhook(w http.ResponseWriter, r *http.Request) {
+	webhook := s.webhookOr404(w, r)
+	if webhook == nil {
+		return
+	}
+
+	before := *webhook
+	if !patch(w, r, webhook) {
+		return
+	}
+	webhook.ID, webhook.Token, webhook.GuildID, webhook.Type = before.ID, before.Token, before.GuildID, before.Type
+
+	if !validWebhookName(webhook.Name) {
+		*webhook = before
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	if _, ok := s.channels[webhook.ChannelID]; !ok {
+		*webhook = before
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownChannel, "Unknown Channel")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+// deleteWebhook handles DELETE /webhooks/{webhook}.
+func (s *Server) deleteWebhook(w http.ResponseWriter, r *http.Request) {
+	webhook := s.webhookOr404(w, r)
+	if webhook == nil {
+		return
+	}
+	delete(s.webhooks, webhook.ID)
+	writeNoContent(w)
+}
+
+// listWebhooks returns the webhooks matching keep, ordered by ID.
+func (s *Server) listWebhooks(keep func(*discordgo.Webhook) bool) []*discordgo.Webhook {
+	webhooks := make([]*discordgo.Webhook, 0)
+	for _, webhook := range s.webhooks {
+		if keep(webhook) {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	sort.Slice(webhooks, func(i, j int) bool { return snowflakeLess(webhooks[i].ID, webhooks[j].ID) })
+	return webhooks
+}
+
+// listChannelWebhooks handles GET /channels/{channel}/webhooks.
+func (s *Server) listChannelWebhooks(w http.ResponseWriter, r *http.Request) {
+	channel := s.channelOr404(w, r)
+	if channel == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.webhookResponses(s.listWebhooks(func(webhook *discordgo.Webhook) bool {
+		return webhook.ChannelID == channel.ID
+	})))
+}
+
+// listGuildWebhooks handles GET /guilds/{guild}/webhooks.
+func (s *Server) listGuildWebhooks(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.webhookResponses(s.listWebhooks(func(webhook *discordgo.Webhook) bool {
+		return webhook.GuildID == g.guild.ID
+	})))
+}
@@ -0,0 +1,935 @@
+package fakediscord
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// defaultEveryonePermissions are the permissions Discord gives @everyone in a new guild.
+const defaultEveryonePermissions int64 = 104324673
+
+// AddUser stores a user that can be added to guilds.
+func (s *Server) AddUser(username string) *discordgo.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user := &discordgo.User{ID: s.newID(), Username: username}
+	s.users[user.ID] = user
+	copied := *user
+	return &copied
+}
+
+// EditUser applies edit to a stored user outside of the API, for example to set up its avatar or banner.
+func (s *Server) EditUser(userID string, edit func(*discordgo.User)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if user, ok := s.users[userID]; ok {
+		edit(user)
+	}
+}
+
+// SetUserAccentColor sets the banner color of a stored user. discordgo.User cannot tell an unset accent color
+// from black, so users have none until this is called.
+func (s *Server) SetUserAccentColor(userID string, color int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.accentColors[userID] = color
+}
+
+// AddGuild creates a guild owned by another user. The bot is a member with a managed "Bot" role at the top of
+// the hierarchy that has the Administrator permission.
+func (s *Server) AddGuild(name string, features ...discordgo.GuildFeature) *discordgo.Guild {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	owner := &discordgo.User{ID: s.newID(), Username: name + "-owner"}
+	s.users[owner.ID] = owner
+
+	g := s.addGuildLocked(name, owner.ID)
+	g.guild.Features = append(g.guild.Features, features...)
+	g.members[owner.ID] = &discordgo.Member{GuildID: g.guild.ID, User: owner, Roles: []string{}, JoinedAt: now()}
+
+	botRole := &discordgo.Role{ID: s.newID(), Name: "Bot", Managed: true, Position: 1, Permissions: discordgo.PermissionAdministrator}
+	g.roles[botRole.ID] = botRole
+	g.roleTags[botRole.ID] = &RoleTags{BotID: s.BotUser.ID}
+	g.members[s.BotUser.ID].Roles = []string{botRole.ID}
+
+	return g.snapshot()
+}
+
+// addGuildLocked creates a guild with its @everyone role and the bot as a member. The caller must hold s.mu.
+func (s *Server) addGuildLocked(name, ownerID string) *guildState {
+	id := s.newID()
+	g := &guildState{
+		guild: &discordgo.Guild{
+			ID:                id,
+			Name:              name,
+			OwnerID:           ownerID,
+			Features:          []discordgo.GuildFeature{},
+			VerificationLevel: discordgo.VerificationLevelNone,
+			PreferredLocale:   "en-US",
+		},
+		roles:    make(map[string]*discordgo.Role),
+		members:  make(map[string]*discordgo.Member),
+		emojis:   make(map[string]*discordgo.Emoji),
+		roleTags: make(map[string]*RoleTags),
+	}
+
+	// The @everyone role shares the guild's ID
+	g.roles[id] = &discordgo.Role{ID: id, Name: "@everyone", Position: 0, Permissions: defaultEveryonePermissions}
+	g.members[s.BotUser.ID] = &discordgo.Member{GuildID: id, User: s.BotUser, Roles: []string{}, JoinedAt: now()}
+
+	s.guilds[id] = g
+	return g
+}
+
+// EditGuild applies edit to a stored guild outside of the API, to set up fields the API cannot, such as the
+// boost tier, or to simulate drift.
+func (s *Server) EditGuild(guildID string, edit func(*discordgo.Guild)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if g, ok := s.guilds[guildID]; ok {
+		edit(g.guild)
+	}
+}
+
+// AddRole creates a role directly above @everyone, like Discord does.
+func (s *Server) AddRole(guildID, name string) *discordgo.Role {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.guilds[guildID]
+	if !ok {
+		return nil
+	}
+	role := s.addRoleLocked(g, discordgo.Role{Name: name})
+	copied := *role
+	return &copied
+}
+
+// AddManagedRole creates a role managed by an integration, a bot or server boosts, directly above @everyone.
+// Managed roles cannot be edited or deleted through the API.
+func (s *Server) AddManagedRole(guildID, name string, tags RoleTags) *discordgo.Role {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.guilds[guildID]
+	if !ok {
+		return nil
+	}
+	role := s.addRoleLocked(g, discordgo.Role{Name: name, Managed: true})
+	g.roleTags[role.ID] = &tags
+	copied := *role
+	return &copied
+}
+
+// EditRole applies edit to a stored role outside of the API, for example to set up its permissions, or to
+// simulate drift.
+func (s *Server) EditRole(guildID, roleID string, edit func(*discordgo.Role)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if g, ok := s.guilds[guildID]; ok {
+		if role, ok := g.roles[roleID]; ok {
+			edit(role)
+		}
+	}
+}
+
+// addRoleLocked inserts a role at position 1 and moves the others up. The caller must hold s.mu.
+func (s *Server) addRoleLocked(g *guildState, role discordgo.Role) *discordgo.Role {
+	for _, existing := range g.roles {
+		if existing.Position > 0 {
+			existing.Position++
+		}
+	}
+	role.ID = s.newID()
+	role.Position = 1
+	g.roles[role.ID] = &role
+	return &role
+}
+
+// AddMember adds a user to a guild with the given roles.
+func (s *Server) AddMember(guildID string, user *discordgo.User, roleIDs ...string) *discordgo.Member {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.guilds[guildID]
+	if !ok {
+		return nil
+	}
+	if _, ok := s.users[user.ID]; !ok {
+		s.users[user.ID] = user
+	}
+	member := &discordgo.Member{GuildID: guildID, User: s.users[user.ID], Roles: append([]string{}, roleIDs...), JoinedAt: now()}
+	g.members[user.ID] = member
+	copied := *member
+	return &copied
+}
+
+// AddAuditLogEntry records an audit log entry in a guild with a new ID, as if the action had been taken in the
+// Discord client.
+func (s *Server) AddAuditLogEntry(guildID string, entry discordgo.AuditLogEntry) *discordgo.AuditLogEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.guilds[guildID]
+	if !ok {
+		return nil
+	}
+	entry.ID = s.newID()
+	g.auditLog = append(g.auditLog, &entry)
+	copied := entry
+	return &copied
+}
+
+// Guild returns a copy of a stored guild with its roles and emojis.
+func (s *Server) Guild(guildID string) (*discordgo.Guild, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.guilds[guildID]
+	if !ok {
+		return nil, false
+	}
+	return g.snapshot(), true
+}
+
+// Role returns a copy of a stored role.
+func (s *Server) Role(guildID, roleID string) (*discordgo.Role, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.guilds[guildID]
+	if !ok {
+		return nil, false
+	}
+	role, ok := g.roles[roleID]
+	if !ok {
+		return nil, false
+	}
+	copied := *role
+	return &copied, true
+}
+
+// Member returns a copy of a stored member.
+func (s *Server) Member(guildID, userID string) (*discordgo.Member, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.guilds[guildID]
+	if !ok {
+		return nil, false
+	}
+	member, ok := g.members[userID]
+	if !ok {
+		return nil, false
+	}
+	copied := *member
+	copied.Roles = append([]string{}, member.Roles...)
+	return &copied, true
+}
+
+// DeleteGuild deletes a guild outside of the API, to simulate drift.
+func (s *Server) DeleteGuild(guildID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deleteGuildLocked(guildID)
+}
+
+// DeleteRole deletes a role outside of the API, to simulate drift.
+func (s *Server) DeleteRole(guildID, roleID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if g, ok := s.guilds[guildID]; ok {
+		g.deleteRole(roleID)
+	}
+}
+
+// RemoveMember removes a member from a guild outside of the API, to simulate drift.
+func (s *Server) RemoveMember(guildID, userID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if g, ok := s.guilds[guildID]; ok {
+		delete(g.members, userID)
+	}
+}
+
+// RemoveMemberRole takes a role away from a member outside of the API, to simulate drift.
+func (s *Server) RemoveMemberRole(guildID, userID, roleID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if g, ok := s.guilds[guildID]; ok {
+		if member, ok := g.members[userID]; ok {
+			member.Roles = removeString(member.Roles, roleID)
+		}
+	}
+}
+
+// DeleteEmoji deletes an emoji outside of the API, to simulate drift.
+func (s *Server) DeleteEmoji(guildID, emojiID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if g, ok := s.guilds[guildID]; ok {
+		delete(g.emojis, emojiID)
+	}
+}
+
+// deleteRole removes a role and takes it away from every member.
+func (g *guildState) deleteRole(roleID string) {
+	delete(g.roles, roleID)
+	delete(g.roleTags, roleID)
+	for _, member := range g.members {
+		member.Roles = removeString(member.Roles, roleID)
+	}
+}
+
+// botTopPosition returns the position of the bot's highest role.
+func (s *Server) botTopPosition(g *guildState) int {
+	top := 0
+	if member, ok := g.members[s.BotUser.ID]; ok {
+		for _, roleID := range member.Roles {
+			if role, ok := g.roles[roleID]; ok && role.Position > top {
+				top = role.Position
+			}
+		}
+	}
+	return top
+}
+
+// canManageRole reports whether the bot may edit, delete, assign or remove a role.
+func (s *Server) canManageRole(g *guildState, role *discordgo.Role) bool {
+	if g.guild.OwnerID == s.BotUser.ID {
+		return true
+	}
+	return role.Position < s.botTopPosition(g)
+}
+
+// removeString returns list without value.
+func removeString(list []string, value string) []string {
+	out := make([]string, 0, len(list))
+	for _, v := range list {
+		if v != value {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// getCurrentUser handles GET /users/@me.
+func (s *Server) getCurrentUser(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.BotUser)
+}
+
+// getCurrentApplication handles GET /applications/@me.
+func (s *Server) getCurrentApplication(w http.ResponseWriter, _ *http.Request) {
+	application := *s.Application
+	application.ApproximateGuildCount = len(s.guilds)
+	writeJSON(w, http.StatusOK, application)
+}
+
+// getUser handles GET /users/{user}.
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := s.users[r.PathValue("user")]
+	if !ok {
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownUser, "Unknown User")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.userResponse(user))
+}
+
+// listCurrentUserGuilds handles GET /users/@me/guilds with before, after, limit and with_counts.
+func (s *Server) listCurrentUserGuilds(w http.ResponseWriter, r *http.Request) {
+	limit := 200
+	if v, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && v > 0 && v <= 200 {
+		limit = v
+	}
+	before := r.URL.Query().Get("before")
+	after := r.URL.Query().Get("after")
+	withCounts := r.URL.Query().Get("with_counts") == "true"
+
+	ids := make([]string, 0, len(s.guilds))
+	for id, g := range s.guilds {
+		if _, member := g.members[s.BotUser.ID]; member {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return snowflakeLess(ids[i], ids[j]) })
+
+	guilds := make([]*discordgo.UserGuild, 0)
+	for _, id := range ids {
+		if after != "" && !snowflakeLess(after, id) {
+			continue
+		}
+		if before != "" && !snowflakeLess(id, before) {
+			continue
+		}
+		g := s.guilds[id]
+		guild := &discordgo.UserGuild{
+			ID:          id,
+			Name:        g.guild.Name,
+			Icon:        g.guild.Icon,
+			Owner:       g.guild.OwnerID == s.BotUser.ID,
+			Permissions: discordgo.PermissionAdministrator,
+			Features:    g.guild.Features,
+		}
+		if withCounts {
+			guild.ApproximateMemberCount, guild.ApproximatePresenceCount = g.approximateCounts()
+		}
+		guilds = append(guilds, guild)
+	}
+
+	// With before, Discord returns the guilds closest to the cursor
+	if before != "" && len(guilds) > limit {
+		guilds = guilds[len(guilds)-limit:]
+	} else if len(guilds) > limit {
+		guilds = guilds[:limit]
+	}
+	writeJSON(w, http.StatusOK, guilds)
+}
+
+// approximateCounts returns the guild's member count and its presence count. The fake has no presences, so
+// only bots count as online.
+func (g *guildState) approximateCounts() (members, presences int) {
+	for _, member := range g.members {
+		if member.User != nil && member.User.Bot {
+			presences++
+		}
+	}
+	return len(g.members), presences
+}
+
+// snowflakeLess compares two snowflakes numerically.
+func snowflakeLess(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+// createGuild handles POST /guilds. The bot becomes the owner.
+func (s *Server) createGuild(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name string `json:"name"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+	if len(body.Name) < 2 || len(body.Name) > 100 {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+
+	g := s.addGuildLocked(body.Name, s.BotUser.ID)
+	writeJSON(w, http.StatusCreated, g.snapshot())
+}
+
+// getGuild handles GET /guilds/{guild}, with approximate counts when with_counts is true.
+func (s *Server) getGuild(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	guild := g.snapshot()
+	if r.URL.Query().Get("with_counts") == "true" {
+		guild.ApproximateMemberCount, guild.ApproximatePresenceCount = g.approximateCounts()
+	}
+	writeJSON(w, http.StatusOK, guild)
+}
+
+// editGuild handles PATCH /guilds/{guild}.
+func (s *Server) editGuild(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	if !patch(w, r, g.guild) {
+		return
+	}
+	writeJSON(w, http.StatusOK, g.snapshot())
+}
+
+// deleteGuild handles DELETE /guilds/{guild}. Only the owner may delete a guild.
+func (s *Server) deleteGuild(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	if g.guild.OwnerID != s.BotUser.ID {
+		writeError(w, http.StatusForbidden, discordgo.ErrCodeMissingPermissions, "Missing Permissions")
+		return
+	}
+
+	s.deleteGuildLocked(g.guild.ID)
+	writeNoContent(w)
+}
+
+// deleteGuildLocked removes a guild with its channels. The caller must hold s.mu.
+func (s *Server) deleteGuildLocked(guildID string) {
+	for id, channel := range s.channels {
+		if channel.GuildID == guildID {
+			s.deleteChannelLocked(id)
+		}
+	}
+	delete(s.guilds, guildID)
+}
+
+// listRoles handles GET /guilds/{guild}/roles.
+func (s *Server) listRoles(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, g.roleResponses(g.sortedRoles()))
+}
+
+// createRole handles POST /guilds/{guild}/roles.
+func (s *Server) createRole(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+
+	var params discordgo.RoleParams
+	if !decode(w, r, &params) {
+		return
+	}
+
+	role := discordgo.Role{Name: params.Name, Permissions: 0}
+	if role.Name == "" {
+		role.Name = "new role"
+	}
+	if params.Color != nil {
+		role.Color = *params.Color
+	}
+	if params.Hoist != nil {
+		role.Hoist = *params.Hoist
+	}
+	if params.Mentionable != nil {
+		role.Mentionable = *params.Mentionable
+	}
+	if params.Permissions != nil {
+		role.Permissions = *params.Permissions
+	}
+
+	writeJSON(w, http.StatusOK, s.addRoleLocked(g, role))
+}
+
+// editRole handles PATCH /guilds/{guild}/roles/{role}.
+func (s *Server) editRole(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	role, ok := g.roles[r.PathValue("role")]
+	if !ok {
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownRole, "Unknown Role")
+		return
+	}
+	if !s.canManageRole(g, role) {
+		writeError(w, http.StatusForbidden, discordgo.ErrCodeMissingPermissions, "Missing Permissions")
+		return
+	}
+
+	id, position, managed := role.ID, role.Position, role.Managed
+	if !patch(w, r, role) {
+		return
+	}
+	// These fields cannot be changed through this endpoint
+	role.ID, role.Position, role.Managed = id, position, managed
+
+	writeJSON(w, http.StatusOK, g.roleResponse(role))
+}
+
+// deleteRole handles DELETE /guilds/{guild}/roles/{role}.
+func (s *Server) deleteRole(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	role, ok := g.roles[r.PathValue("role")]
+	if !ok {
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownRole, "Unknown Role")
+		return
+	}
+	if role.ID == g.guild.ID || role.Managed || !s.canManageRole(g, role) {
+		writeError(w, http.StatusForbidden, discordgo.ErrCodeMissingPermissions, "Missing Permissions")
+		return
+	}
+
+	g.deleteRole(role.ID)
+	writeNoContent(w)
+}
+
+// reorderRoles handles PATCH /guilds/{guild}/roles with a list of role positions.
+func (s *Server) reorderRoles(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+
+	var positions []struct {
+		ID       string `json:"id"`
+		Position int    `json:"position"`
+	}
+	if !decode(w, r, &positions) {
+		return
+	}
+
+	for _, p := range positions {
+		role, ok := g.roles[p.ID]
+		if !ok {
+			writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownRole, "Unknown Role")
+			return
+		}
+		if !s.canManageRole(g, role) || p.Position >= s.botTopPosition(g) && g.guild.OwnerID != s.BotUser.ID {
+			writeError(w, http.StatusForbidden, discordgo.ErrCodeMissingPermissions, "Missing Permissions")
+			return
+		}
+	}
+	for _, p := range positions {
+		g.roles[p.ID].Position = p.Position
+	}
+
+	writeJSON(w, http.StatusOK, g.roleResponses(g.sortedRoles()))
+}
+
+// sortedMembers returns the guild's members ordered by user ID.
+func (g *guildState) sortedMembers() []*discordgo.Member {
+	members := make([]*discordgo.Member, 0, len(g.members))
+	for _, member := range g.members {
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool { return snowflakeLess(members[i].User.ID, members[j].User.ID) })
+	return members
+}
+
+// listMembers handles GET /guilds/{guild}/members with after and limit.
+func (s *Server) listMembers(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+
+	limit := 1
+	if v, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && v > 0 && v <= 1000 {
+		limit = v
+	}
+	after := r.URL.Query().Get("after")
+
+	members := make([]*discordgo.Member, 0)
+	for _, member := range g.sortedMembers() {
+		if after != "" && !snowflakeLess(after, member.User.ID) {
+			continue
+		}
+		members = append(members, member)
+		if len(members) == limit {
+			break
+		}
+	}
+	writeJSON(w, http.StatusOK, members)
+}
+
+// listAuditLog handles GET /guilds/{guild}/audit-logs, newest entry first, filtered by user_id, action_type and
+// the before and after cursors.
+func (s *Server) listAuditLog(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	limit := 50
+	if v, err := strconv.Atoi(query.Get("limit")); err == nil && v > 0 && v <= 100 {
+		limit = v
+	}
+	actionType, _ := strconv.Atoi(query.Get("action_type"))
+	userID, before, after := query.Get("user_id"), query.Get("before"), query.Get("after")
+
+	log := discordgo.GuildAuditLog{
+		AuditLogEntries: make([]*discordgo.AuditLogEntry, 0),
+		Users:           make([]*discordgo.User, 0),
+		Webhooks:        make([]*discordgo.Webhook, 0),
+		Integrations:    make([]*discordgo.Integration, 0),
+	}
+	seen := make(map[string]bool)
+	for i := len(g.auditLog) - 1; i >= 0 && len(log.AuditLogEntries) < limit; i-- {
+		entry := g.auditLog[i]
+		if userID != "" && entry.UserID != userID {
+			continue
+		}
+		if actionType != 0 && (entry.ActionType == nil || int(*entry.ActionType) != actionType) {
+			continue
+		}
+		if before != "" && !snowflakeLess(entry.ID, before) {
+			continue
+		}
+		if after != "" && !snowflakeLess(after, entry.ID) {
+			continue
+		}
+		log.AuditLogEntries = append(log.AuditLogEntries, entry)
+		if user, ok := s.users[entry.UserID]; ok && !seen[user.ID] {
+			seen[user.ID] = true
+			log.Users = append(log.Users, user)
+		}
+	}
+	writeJSON(w, http.StatusOK, log)
+}
+
+// searchMembers handles GET /guilds/{guild}/members/search, matching the start of usernames and nicknames.
+func (s *Server) searchMembers(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+
+	query := strings.ToLower(r.URL.Query().Get("query"))
+	limit := 1
+	if v, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && v > 0 && v <= 1000 {
+		limit = v
+	}
+
+	members := make([]*discordgo.Member, 0)
+	for _, member := range g.sortedMembers() {
+		if strings.HasPrefix(strings.ToLower(member.User.Username), query) || strings.HasPrefix(strings.ToLower(member.Nick), query) {
+			members = append(members, member)
+		}
+		if len(members) == limit {
+			break
+		}
+	}
+	writeJSON(w, http.StatusOK, members)
+}
+
+// memberOr404 returns the member named in the path, or answers Unknown Member.
+func memberOr404(w http.ResponseWriter, r *http.Request, g *guildState) *discordgo.Member {
+	member, ok := g.members[r.PathValue("user")]
+	if !ok {
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownMember, "Unknown Member")
+		return nil
+	}
+	return member
+}
+
+// getMember handles GET /guilds/{guild}/members/{user}.
+func (s *Server) getMember(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	member := memberOr404(w, r, g)
+	if member == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, member)
+}
+
+// editMember handles PATCH /guilds/{guild}/members/{user}, including replacing the role list.
+func (s *Server) editMember(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	member := memberOr404(w, r, g)
+	if member == nil {
+		return
+	}
+
+	before := append([]string{}, member.Roles...)
+	user, guildID := member.User, member.GuildID
+	if !patch(w, r, member) {
+		return
+	}
+	member.User, member.GuildID = user, guildID
+
+	// Every added or removed role must exist and be below the bot's highest role
+	changed := append(diffStrings(member.Roles, before), diffStrings(before, member.Roles)...)
+	for _, roleID := range changed {
+		role, ok := g.roles[roleID]
+		if !ok {
+			member.Roles = before
+			writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+			return
+		}
+		if role.Managed || !s.canManageRole(g, role) {
+			member.Roles = before
+			writeError(w, http.StatusForbidden, discordgo.ErrCodeMissingPermissions, "Missing Permissions")
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, member)
+}
+
+// diffStrings returns the elements of a that are not in b.
+func diffStrings(a, b []string) []string {
+	seen := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		seen[v] = struct{}{}
+	}
+	out := make([]string, 0)
+	for _, v := range a {
+		if _, ok := seen[v]; !ok {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// memberRole checks the member and role named in the path for the role add and remove endpoints.
+func (s *Server) memberRole(w http.ResponseWriter, r *http.Request) (*discordgo.Member, string) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return nil, ""
+	}
+	member := memberOr404(w, r, g)
+	if member == nil {
+		return nil, ""
+	}
+	role, ok := g.roles[r.PathValue("role")]
+	if !ok {
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownRole, "Unknown Role")
+		return nil, ""
+	}
+	if role.Managed || !s.canManageRole(g, role) {
+		writeError(w, http.StatusForbidden, discordgo.ErrCodeMissingPermissions, "Missing Permissions")
+		return nil, ""
+	}
+	return member, role.ID
+}
+
+// addMemberRole handles PUT /guilds/{guild}/members/{user}/roles/{role}.
+func (s *Server) addMemberRole(w http.ResponseWriter, r *http.Request) {
+	member, roleID := s.memberRole(w, r)
+	if member == nil {
+		return
+	}
+	member.Roles = append(removeString(member.Roles, roleID), roleID)
+	writeNoContent(w)
+}
+
+// removeMemberRole handles DELETE /guilds/{guild}/members/{user}/roles/{role}.
+func (s *Server) removeMemberRole(w http.ResponseWriter, r *http.Request) {
+	member, roleID := s.memberRole(w, r)
+	if member == nil {
+		return
+	}
+	member.Roles = removeString(member.Roles, roleID)
+	writeNoContent(w)
+}
+
+// listEmojis handles GET /guilds/{guild}/emojis.
+func (s *Server) listEmojis(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	emojis := make([]*discordgo.Emoji, 0, len(g.emojis))
+	for _, emoji := range g.emojis {
+		emojis = append(emojis, emoji)
+	}
+	sort.Slice(emojis, func(i, j int) bool { return snowflakeLess(emojis[i].ID, emojis[j].ID) })
+	writeJSON(w, http.StatusOK, emojis)
+}
+
+// emojiOr404 returns the emoji named in the path, or answers Unknown Emoji.
+func emojiOr404(w http.ResponseWriter, r *http.Request, g *guildState) *discordgo.Emoji {
+	emoji, ok := g.emojis[r.PathValue("emoji")]
+	if !ok {
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownEmoji, "Unknown Emoji")
+		return nil
+	}
+	return emoji
+}
+
+// createEmoji handles POST /guilds/{guild}/emojis.
+func (s *Server) createEmoji(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+
+	var params discordgo.EmojiParams
+	if !decode(w, r, &params) {
+		return
+	}
+	if len(params.Name) < 2 || len(params.Name) > 32 || !strings.HasPrefix(params.Image, "data:image/") {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+
+	roles := params.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	emoji := &discordgo.Emoji{
+		ID:            s.newID(),
+		Name:          params.Name,
+		Roles:         roles,
+		User:          s.BotUser,
+		RequireColons: true,
+		Animated:      strings.HasPrefix(params.Image, "data:image/gif"),
+		Available:     true,
+	}
+	g.emojis[emoji.ID] = emoji
+	writeJSON(w, http.StatusCreated, emoji)
+}
+
+// getEmoji handles GET /guilds/{guild}/emojis/{emoji}.
+func (s *Server) getEmoji(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	emoji := emojiOr404(w, r, g)
+	if emoji == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, emoji)
+}
+
+// editEmoji handles PATCH /guilds/{guild}/emojis/{emoji}.
+func (s *Server) editEmoji(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	emoji := emojiOr404(w, r, g)
+	if emoji == nil {
+		return
+	}
+
+	id, animated := emoji.ID, emoji.Animated
+	if !patch(w, r, emoji) {
+		return
+	}
+	emoji.ID, emoji.Animated = id, animated
+
+	writeJSON(w, http.StatusOK, emoji)
+}
+
+// deleteEmoji handles DELETE /guilds/{guild}/emojis/{emoji}.
+func (s *Server) deleteEmoji(w http.ResponseWriter, r *http.Request) {
+	g := s.guildOr404(w, r)
+	if g == nil {
+		return
+	}
+	emoji := emojiOr404(w, r, g)
+	if emoji == nil {
+		return
+	}
+	delete(g.emojis, emoji.ID)
+	writeNoContent(w)
+}
@@ -0,0 +1,463 @@
+// Package fakediscord is an in-process fake of the Discord REST API for hermetic tests.
+//
+// The fake keeps guilds, channels, roles, members, permission overwrites, webhooks, invites, emojis,
+// messages and audit log entries in memory and answers with the same JSON shapes, JSON error codes and rate limit headers as
+// Discord, so the provider can be exercised end to end without a network connection or a bot token.
+package fakediscord
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Token is the bot token the fake accepts.
+const Token = "fake-bot-token"
+
+// firstID is the first snowflake handed out by the fake.
+const firstID = 100000000000000000
+
+// guildState holds everything stored for a guild.
+type guildState struct {
+	guild   *discordgo.Guild
+	roles   map[string]*discordgo.Role
+	members map[string]*discordgo.Member
+	emojis  map[string]*discordgo.Emoji
+	// auditLog holds the guild's audit log entries, oldest first.
+	auditLog []*discordgo.AuditLogEntry
+	// roleTags holds the tags of managed roles by role ID.
+	roleTags map[string]*RoleTags
+}
+
+// RoleTags are the tags Discord sets on managed roles. discordgo.Role lacks them.
+type RoleTags struct {
+	BotID         string
+	IntegrationID string
+	// PremiumSubscriber marks the guild's booster role.
+	PremiumSubscriber bool
+}
+
+// MarshalJSON encodes the tags as Discord does: boolean tags are null when set and left out otherwise.
+func (t RoleTags) MarshalJSON() ([]byte, error) {
+	out := map[string]interface{}{}
+	if t.BotID != "" {
+		out["bot_id"] = t.BotID
+	}
+	if t.IntegrationID != "" {
+		out["integration_id"] = t.IntegrationID
+	}
+	if t.PremiumSubscriber {
+		out["premium_subscriber"] = nil
+	}
+	return json.Marshal(out)
+}
+
+// Application is an application as GET /applications/@me returns it. discordgo.Application lacks the install
+// params and the approximate guild count.
+type Application struct {
+	discordgo.Application
+	InstallParams *InstallParams `json:"install_params,omitempty"`
+	// ApproximateGuildCount is filled in from the stored guilds when the application is served.
+	ApproximateGuildCount int `json:"approximate_guild_count"`
+}
+
+// InstallParams are the default scopes and permissions of an application's in-app authorization link.
+type InstallParams struct {
+	Scopes      []string `json:"scopes"`
+	Permissions string   `json:"permissions"`
+}
+
+// Server is a fake Discord REST API served from an httptest.Server.
+type Server struct {
+	// URL is the base URL to configure as the provider's api_url, without the API version.
+	URL string
+	// BotUser is the user the bot token authenticates as. It is a member of every guild.
+	BotUser *discordgo.User
+	// Application is the application the bot belongs to. It shares the bot user's ID, as in Discord.
+	Application *Application
+
+	httpServer *httptest.Server
+
+	mu        sync.Mutex
+	nextID    uint64
+	guilds    map[string]*guildState
+	channels  map[string]*discordgo.Channel
+	messages  map[string]map[string]*discordgo.Message
+	webhooks  map[string]*discordgo.Webhook
+	invites   map[string]*discordgo.Invite
+	users     map[string]*discordgo.User
+	requests  []string
+	rateLimit int
+	latency   time.Duration
+	// bareNotFoundPrefix makes GET requests under this path answer 404 without a Discord error code.
+	bareNotFoundPrefix string
+
+	// webhookSources maps channel follower webhook IDs to the channel they follow.
+	webhookSources map[string]string
+	// accentColors holds the banner colors of users that have one. Discord sends null for the others.
+	accentColors map[string]int
+}
+
+// New starts a fake Discord API. Call Close when done.
+func New() *Server {
+	s := &Server{
+		nextID:   firstID,
+		guilds:   make(map[string]*guildState),
+		channels: make(map[string]*discordgo.Channel),
+		messages: make(map[string]map[string]*discordgo.Message),
+		webhooks: make(map[string]*discordgo.Webhook),
+		invites:  make(map[string]*discordgo.Invite),
+		users:    make(map[string]*discordgo.User),
+
+		webhookSources: make(map[string]string),
+		accentColors:   make(map[string]int),
+	}
+
+	s.BotUser = &discordgo.User{ID: s.newID(), Username: "terraform-bot", Bot: true}
+	s.users[s.BotUser.ID] = s.BotUser
+	s.Application = &Application{
+		Application: discordgo.Application{
+			ID:        s.BotUser.ID,
+			Name:      s.BotUser.Username,
+			BotPublic: true,
+			Owner:     &discordgo.User{ID: s.newID(), Username: "terraform-bot-owner"},
+		},
+		InstallParams: &InstallParams{Scopes: []string{"bot", "applications.commands"}, Permissions: "8"},
+	}
+
+	s.httpServer = httptest.NewServer(s.routes())
+	s.URL = s.httpServer.URL + "/api"
+
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// Requests returns the requests served so far as "METHOD /path" strings, without the API version prefix.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+// RateLimitNext makes the next n requests fail with a 429 response, to exercise retries.
+func (s *Server) RateLimitNext(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateLimit = n
+}
+
+// SetBareNotFound makes GET requests whose path (without the API version) starts with prefix answer a plain 404
+// without a Discord error code, as a proxy or a wrong API URL would. An empty prefix turns this off.
+func (s *Server) SetBareNotFound(prefix string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.bareNotFoundPrefix = prefix
+}
+
+// SetLatency delays every response by d, to simulate a slow or hung API. A request whose client gives up
+// earlier is abandoned without a response.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.latency = d
+}
+
+// newID returns a new snowflake. The caller must hold s.mu, or be the constructor.
+func (s *Server) newID() string {
+	s.nextID++
+	return strconv.FormatUint(s.nextID, 10)
+}
+
+// routes registers every supported endpoint.
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	handle := func(pattern string, h func(w http.ResponseWriter, r *http.Request)) {
+		mux.HandleFunc(pattern, s.wrap(h))
+	}
+
+	handle("GET /api/{version}/users/@me", s.getCurrentUser)
+	handle("GET /api/{version}/applications/@me", s.getCurrentApplication)
+	handle("GET /api/{version}/users/@me/guilds", s.listCurrentUserGuilds)
+	handle("GET /api/{version}/users/{user}", s.getUser)
+
+	handle("POST /api/{version}/guilds", s.createGuild)
+	handle("GET /api/{version}/guilds/{guild}", s.getGuild)
+	handle("PATCH /api/{version}/guilds/{guild}", s.editGuild)
+	handle("DELETE /api/{version}/guilds/{guild}", s.deleteGuild)
+
+	handle("GET /api/{version}/guilds/{guild}/roles", s.listRoles)
+	handle("POST /api/{version}/guilds/{guild}/roles", s.createRole)
+	handle("PATCH /api/{version}/guilds/{guild}/roles", s.reorderRoles)
+	handle("PATCH /api/{version}/guilds/{guild}/roles/{role}", s.editRole)
+	handle("DELETE /api/{version}/guilds/{guild}/roles/{role}", s.deleteRole)
+
+	handle("GET /api/{version}/guilds/{guild}/members", s.listMembers)
+	handle("GET /api/{version}/guilds/{guild}/members/search", s.searchMembers)
+	handle("GET /api/{version}/guilds/{guild}/members/{user}", s.getMember)
+	handle("PATCH /api/{version}/guilds/{guild}/members/{user}", s.editMember)
+	handle("PUT /api/{version}/guilds/{guild}/members/{user}/roles/{role}", s.addMemberRole)
+	handle("DELETE /api/{version}/guilds/{guild}/members/{user}/roles/{role}", s.removeMemberRole)
+
+	handle("GET /api/{version}/guilds/{guild}/emojis", s.listEmojis)
+	handle("POST /api/{version}/guilds/{guild}/emojis", s.createEmoji)
+	handle("GET /api/{version}/guilds/{guild}/emojis/{emoji}", s.getEmoji)
+	handle("PATCH /api/{version}/guilds/{guild}/emojis/{emoji}", s.editEmoji)
+	handle("DELETE /api/{version}/guilds/{guild}/emojis/{emoji}", s.deleteEmoji)
+
+	handle("GET /api/{version}/guilds/{guild}/audit-logs", s.listAuditLog)
+
+	handle("GET /api/{version}/guilds/{guild}/channels", s.listChannels)
+	handle("POST /api/{version}/guilds/{guild}/channels", s.createChannel)
+	handle("PATCH /api/{version}/guilds/{guild}/channels", s.reorderChannels)
+	handle("GET /api/{version}/guilds/{guild}/invites", s.listGuildInvites)
+	handle("GET /api/{version}/guilds/{guild}/webhooks", s.listGuildWebhooks)
+
+	handle("GET /api/{version}/channels/{channel}", s.getChannel)
+	handle("PATCH /api/{version}/channels/{channel}", s.editChannel)
+	handle("DELETE /api/{version}/channels/{channel}", s.deleteChannel)
+	handle("PUT /api/{version}/channels/{channel}/permissions/{overwrite}", s.setOverwrite)
+	handle("DELETE /api/{version}/channels/{channel}/permissions/{overwrite}", s.deleteOverwrite)
+
+	handle("POST /api/{version}/channels/{channel}/messages", s.createMessage)
+	handle("GET /api/{version}/channels/{channel}/messages/{message}", s.getMessage)
+	handle("PATCH /api/{version}/channels/{channel}/messages/{message}", s.editMessage)
+	handle("DELETE /api/{version}/channels/{channel}/messages/{message}", s.deleteMessage)
+
+	handle("GET /api/{version}/channels/{channel}/invites", s.listChannelInvites)
+	handle("POST /api/{version}/channels/{channel}/invites", s.createInvite)
+	handle("GET /api/{version}/invites/{code}", s.getInvite)
+	handle("DELETE /api/{version}/invites/{code}", s.deleteInvite)
+
+	handle("GET /api/{version}/channels/{channel}/webhooks", s.listChannelWebhooks)
+	handle("POST /api/{version}/channels/{channel}/webhooks", s.createWebhook)
+	handle("GET /api/{version}/webhooks/{webhook}", s.getWebhook)
+	handle("PATCH /api/{version}/webhooks/{webhook}", s.editWebhook)
+	handle("DELETE /api/{version}/webhooks/{webhook}", s.deleteWebhook)
+
+	mux.HandleFunc("/", s.wrap(func(w http.ResponseWriter, _ *http.Request) {
+		writeError(w, http.StatusNotFound, 0, "404: Not Found")
+	}))
+
+	return mux
+}
+
+// wrap authenticates the request, records it, adds rate limit headers and serialises access to the state.
+func (s *Server) wrap(h func(w http.ResponseWriter, r *http.Request)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		latency := s.latency
+		s.mu.Unlock()
+
+		if latency > 0 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(latency):
+			}
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		path := r.URL.Path
+		if version := r.PathValue("version"); version != "" {
+			path = path[len("/api/"+version):]
+		}
+		s.requests = append(s.requests, r.Method+" "+path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Bucket", bucketHash(r.Pattern))
+		w.Header().Set("X-RateLimit-Limit", "50")
+		w.Header().Set("X-RateLimit-Remaining", "49")
+		w.Header().Set("X-RateLimit-Reset-After", "1")
+
+		if r.Header.Get("Authorization") != "Bot "+Token {
+			writeError(w, http.StatusUnauthorized, 0, "401: Unauthorized")
+			return
+		}
+
+		if s.rateLimit > 0 {
+			s.rateLimit--
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset-After", "0.01")
+			w.Header().Set("Retry-After", "0.01")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_ = json.NewEncoder(w).Encode(map[string]any{"message": "You are being rate limited.", "retry_after": 0.01, "global": false})
+			return
+		}
+
+		if s.bareNotFoundPrefix != "" && r.Method == http.MethodGet && strings.HasPrefix(path, s.bareNotFoundPrefix) {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("404 page not found\n"))
+			return
+		}
+
+		h(w, r)
+	}
+}
+
+// bucketHash returns a stable rate limit bucket hash for a route pattern.
+func bucketHash(pattern string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(pattern))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// writeJSON writes v with the given status.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes a Discord JSON error.
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	writeJSON(w, status, map[string]any{"message": message, "code": code})
+}
+
+// writeNoContent writes an empty 204 response.
+func writeNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decode reads a JSON request body into v, answering 400 on failure.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return false
+	}
+	return true
+}
+
+// patch applies the JSON body of r to v: fields present in the body replace those in v.
+func patch(w http.ResponseWriter, r *http.Request, v any) bool {
+	var changes map[string]json.RawMessage
+	if !decode(w, r, &changes) {
+		return false
+	}
+
+	current, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, 0, err.Error())
+		return false
+	}
+
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(current, &merged); err != nil {
+		writeError(w, http.StatusInternalServerError, 0, err.Error())
+		return false
+	}
+	for key, value := range changes {
+		merged[key] = value
+	}
+
+	body, err := json.Marshal(merged)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, 0, err.Error())
+		return false
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return false
+	}
+	return true
+}
+
+// guildOr404 returns the guild named in the path, or answers Unknown Guild.
+func (s *Server) guildOr404(w http.ResponseWriter, r *http.Request) *guildState {
+	g, ok := s.guilds[r.PathValue("guild")]
+	if !ok {
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownGuild, "Unknown Guild")
+		return nil
+	}
+	return g
+}
+
+// channelOr404 returns the channel named in the path, or answers Unknown Channel.
+func (s *Server) channelOr404(w http.ResponseWriter, r *http.Request) *discordgo.Channel {
+	c, ok := s.channels[r.PathValue("channel")]
+	if !ok {
+		writeError(w, http.StatusNotFound, discordgo.ErrCodeUnknownChannel, "Unknown Channel")
+		return nil
+	}
+	return c
+}
+
+// sortedRoles returns the guild's roles ordered by position, then ID.
+func (g *guildState) sortedRoles() []*discordgo.Role {
+	roles := make([]*discordgo.Role, 0, len(g.roles))
+	for _, role := range g.roles {
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		if roles[i].Position != roles[j].Position {
+			return roles[i].Position < roles[j].Position
+		}
+		return roles[i].ID < roles[j].ID
+	})
+	return roles
+}
+
+// roleResponses returns the roles as Discord returns them, with the tags of managed roles.
+func (g *guildState) roleResponses(roles []*discordgo.Role) []any {
+	out := make([]any, 0, len(roles))
+	for _, role := range roles {
+		out = append(out, g.roleResponse(role))
+	}
+	return out
+}
+
+// roleResponse returns the role as Discord returns it, with its tags if it is managed.
+func (g *guildState) roleResponse(role *discordgo.Role) any {
+	return struct {
+		*discordgo.Role
+		Tags *RoleTags `json:"tags,omitempty"`
+	}{role, g.roleTags[role.ID]}
+}
+
+// userResponse returns the user as Discord returns it, with a null accent color if none is set.
+func (s *Server) userResponse(user *discordgo.User) any {
+	var accentColor *int
+	if color, ok := s.accentColors[user.ID]; ok {
+		accentColor = &color
+	}
+	return struct {
+		*discordgo.User
+		AccentColor *int `json:"accent_color"`
+	}{user, accentColor}
+}
+
+// snapshot returns the guild object as Discord returns it, with roles and emojis.
+func (g *guildState) snapshot() *discordgo.Guild {
+	guild := *g.guild
+	guild.Roles = g.sortedRoles()
+	guild.Emojis = make([]*discordgo.Emoji, 0, len(g.emojis))
+	for _, emoji := range g.emojis {
+		guild.Emojis = append(guild.Emojis, emoji)
+	}
+	guild.MemberCount = len(g.members)
+	return &guild
+}
+
+// now returns the current time truncated to seconds, as Discord timestamps are.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
@@ -0,0 +1,440 @@
+package fakediscord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rewriteTransport sends requests for discordgo's API base URL to the fake instead.
+type rewriteTransport struct {
+	to string
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rewritten, err := url.Parse(t.to + strings.TrimPrefix(req.URL.String(), discordgo.EndpointAPI))
+	if err != nil {
+		return nil, err
+	}
+	out := req.Clone(req.Context())
+	out.URL = rewritten
+	out.Host = rewritten.Host
+	return http.DefaultTransport.RoundTrip(out)
+}
+
+// newTestSession returns a fake server and a discordgo session pointed at it.
+func newTestSession(t *testing.T, token string) (*Server, *discordgo.Session) {
+	t.Helper()
+
+	s := New()
+	t.Cleanup(s.Close)
+
+	dg, err := discordgo.New("Bot " + token)
+	require.NoError(t, err)
+	dg.Client = &http.Client{Transport: rewriteTransport{to: s.URL + "/v" + discordgo.APIVersion + "/"}}
+	dg.ShouldRetryOnRateLimit = false
+	dg.MaxRestRetries = 0
+
+	return s, dg
+}
+
+// restErrorCode returns the Discord JSON error code of err, or 0.
+func restErrorCode(t *testing.T, err error) int {
+	t.Helper()
+
+	var restErr *discordgo.RESTError
+	require.True(t, errors.As(err, &restErr), "expected a REST error, got %v", err)
+	if restErr.Message == nil {
+		return 0
+	}
+	return restErr.Message.Code
+}
+
+func TestServer_RejectsUnknownToken(t *testing.T) {
+	_, dg := newTestSession(t, "wrong-token")
+
+	_, err := dg.User("@me")
+	require.Error(t, err)
+
+	var restErr *discordgo.RESTError
+	require.True(t, errors.As(err, &restErr))
+	assert.Equal(t, http.StatusUnauthorized, restErr.Response.StatusCode)
+}
+
+func TestServer_CurrentUserAndGuilds(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	guild := s.AddGuild("Test Guild")
+
+	user, err := dg.User("@me")
+	require.NoError(t, err)
+	assert.Equal(t, s.BotUser.ID, user.ID)
+
+	guilds, err := dg.UserGuilds(100, "", "", false)
+	require.NoError(t, err)
+	require.Len(t, guilds, 1)
+	assert.Equal(t, guild.ID, guilds[0].ID)
+	assert.Equal(t, "Test Guild", guilds[0].Name)
+
+	body, err := dg.RequestWithBucketID("GET", discordgo.EndpointApplication("@me"), nil, discordgo.EndpointApplication("@me"))
+	require.NoError(t, err)
+	var application Application
+	require.NoError(t, json.Unmarshal(body, &application))
+	assert.Equal(t, s.BotUser.ID, application.ID)
+	assert.Equal(t, 1, application.ApproximateGuildCount)
+	require.NotNil(t, application.InstallParams)
+	assert.Contains(t, application.InstallParams.Scopes, "bot")
+}
+
+func TestServer_ChannelLifecycle(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	guild := s.AddGuild("Test Guild")
+
+	category, err := dg.GuildChannelCreateComplex(guild.ID, discordgo.GuildChannelCreateData{
+		Name: "general",
+		Type: discordgo.ChannelTypeGuildCategory,
+	})
+	require.NoError(t, err)
+
+	channel, err := dg.GuildChannelCreateComplex(guild.ID, discordgo.GuildChannelCreateData{
+		Name:     "chat",
+		Type:     discordgo.ChannelTypeGuildText,
+		Topic:    "hello",
+		ParentID: category.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, category.ID, channel.ParentID)
+
+	topic := "updated"
+	edited, err := dg.ChannelEdit(channel.ID, &discordgo.ChannelEdit{Topic: topic})
+	require.NoError(t, err)
+	assert.Equal(t, "updated", edited.Topic)
+	assert.Equal(t, "chat", edited.Name)
+
+	_, err = dg.ChannelDelete(category.ID)
+	require.NoError(t, err)
+
+	got, err := dg.Channel(channel.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.ParentID, "channels in a deleted category move to the top level")
+
+	_, err = dg.Channel(category.ID)
+	assert.Equal(t, discordgo.ErrCodeUnknownChannel, restErrorCode(t, err))
+}
+
+func TestServer_CommunityChannelTypes(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	guild := s.AddGuild("Test Guild")
+
+	_, err := dg.GuildChannelCreateComplex(guild.ID, discordgo.GuildChannelCreateData{
+		Name: "announcements",
+		Type: discordgo.ChannelTypeGuildNews,
+	})
+	require.Error(t, err)
+	assert.Equal(t, discordgo.ErrCodeInvalidFormBody, restErrorCode(t, err))
+	assert.Contains(t, err.Error(), "COMMUNITY")
+
+	community := s.AddGuild("Community Guild", discordgo.GuildFeatureCommunity)
+	_, err = dg.GuildChannelCreateComplex(community.ID, discordgo.GuildChannelCreateData{
+		Name: "announcements",
+		Type: discordgo.ChannelTypeGuildNews,
+	})
+	assert.NoError(t, err)
+}
+
+func TestServer_PermissionOverwrites(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	guild := s.AddGuild("Test Guild")
+	channel := s.AddChannel(guild.ID, "chat", discordgo.ChannelTypeGuildText, "")
+	role := s.AddRole(guild.ID, "Members")
+
+	err := dg.ChannelPermissionSet(channel.ID, role.ID, discordgo.PermissionOverwriteTypeRole, discordgo.PermissionViewChannel, 0)
+	require.NoError(t, err)
+
+	got, err := dg.Channel(channel.ID)
+	require.NoError(t, err)
+	require.Len(t, got.PermissionOverwrites, 1)
+	assert.Equal(t, role.ID, got.PermissionOverwrites[0].ID)
+	assert.Equal(t, int64(discordgo.PermissionViewChannel), got.PermissionOverwrites[0].Allow)
+
+	require.NoError(t, dg.ChannelPermissionDelete(channel.ID, role.ID))
+
+	got, err = dg.Channel(channel.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.PermissionOverwrites)
+}
+
+func TestServer_RoleHierarchy(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	guild := s.AddGuild("Test Guild")
+
+	role, err := dg.GuildRoleCreate(guild.ID, &discordgo.RoleParams{Name: "Moderators"})
+	require.NoError(t, err)
+
+	roles, err := dg.GuildRoles(guild.ID)
+	require.NoError(t, err)
+
+	var botRole *discordgo.Role
+	for _, r := range roles {
+		if r.Managed {
+			botRole = r
+		}
+	}
+	require.NotNil(t, botRole)
+	assert.Greater(t, botRole.Position, role.Position)
+
+	// The bot cannot move a role above its own highest role
+	_, err = dg.GuildRoleReorder(guild.ID, []*discordgo.Role{{ID: role.ID, Position: botRole.Position + 1}})
+	require.Error(t, err)
+	assert.Equal(t, discordgo.ErrCodeMissingPermissions, restErrorCode(t, err))
+
+	require.NoError(t, dg.GuildRoleDelete(guild.ID, role.ID))
+	err = dg.GuildRoleDelete(guild.ID, role.ID)
+	assert.Equal(t, discordgo.ErrCodeUnknownRole, restErrorCode(t, err))
+}
+
+func TestServer_RoleTags(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	guild := s.AddGuild("Test Guild")
+	booster := s.AddManagedRole(guild.ID, "Server Booster", RoleTags{PremiumSubscriber: true})
+	require.NotNil(t, booster)
+
+	endpoint := discordgo.EndpointGuildRoles(guild.ID)
+	body, err := dg.RequestWithBucketID("GET", endpoint, nil, endpoint)
+	require.NoError(t, err)
+	var roles []struct {
+		ID   string                     `json:"id"`
+		Tags map[string]json.RawMessage `json:"tags"`
+	}
+	require.NoError(t, json.Unmarshal(body, &roles))
+
+	tags := map[string]map[string]json.RawMessage{}
+	for _, role := range roles {
+		tags[role.ID] = role.Tags
+	}
+	assert.Nil(t, tags[guild.ID])
+	require.Contains(t, tags, booster.ID)
+	// Discord sends boolean tags as null when they are set
+	assert.Equal(t, json.RawMessage("null"), tags[booster.ID]["premium_subscriber"])
+
+	// The managed role cannot be deleted through the API
+	err = dg.GuildRoleDelete(guild.ID, booster.ID)
+	require.Error(t, err)
+}
+
+func TestServer_UserAccentColor(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	unset := s.AddUser("unset")
+	black := s.AddUser("black")
+	s.SetUserAccentColor(black.ID, 0)
+
+	accentColor := func(userID string) json.RawMessage {
+		endpoint := discordgo.EndpointUser(userID)
+		body, err := dg.RequestWithBucketID("GET", endpoint, nil, endpoint)
+		require.NoError(t, err)
+		var user map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(body, &user))
+		return user["accent_color"]
+	}
+
+	// Discord sends null for an unset accent color, which is not the same as black
+	assert.Equal(t, json.RawMessage("null"), accentColor(unset.ID))
+	assert.Equal(t, json.RawMessage("0"), accentColor(black.ID))
+}
+
+func TestServer_MemberRoles(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	guild := s.AddGuild("Test Guild")
+	role := s.AddRole(guild.ID, "Members")
+	user := s.AddUser("alice")
+	s.AddMember(guild.ID, user)
+
+	require.NoError(t, dg.GuildMemberRoleAdd(guild.ID, user.ID, role.ID))
+
+	member, err := dg.GuildMember(guild.ID, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{role.ID}, member.Roles)
+
+	s.RemoveMember(guild.ID, user.ID)
+	_, err = dg.GuildMember(guild.ID, user.ID)
+	assert.Equal(t, discordgo.ErrCodeUnknownMember, restErrorCode(t, err))
+}
+
+func TestServer_AuditLog(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	guild := s.AddGuild("Test Guild")
+	alice := s.AddUser("alice")
+	roleUpdate := discordgo.AuditLogActionRoleUpdate
+	channelCreate := discordgo.AuditLogActionChannelCreate
+	first := s.AddAuditLogEntry(guild.ID, discordgo.AuditLogEntry{UserID: alice.ID, ActionType: &roleUpdate})
+	second := s.AddAuditLogEntry(guild.ID, discordgo.AuditLogEntry{UserID: s.BotUser.ID, ActionType: &channelCreate})
+	third := s.AddAuditLogEntry(guild.ID, discordgo.AuditLogEntry{UserID: alice.ID, ActionType: &roleUpdate})
+
+	log, err := dg.GuildAuditLog(guild.ID, "", "", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, log.AuditLogEntries, 3)
+	assert.Equal(t, third.ID, log.AuditLogEntries[0].ID, "newest entry first")
+	assert.Len(t, log.Users, 2)
+
+	log, err = dg.GuildAuditLog(guild.ID, alice.ID, third.ID, int(roleUpdate), 0)
+	require.NoError(t, err)
+	require.Len(t, log.AuditLogEntries, 1)
+	assert.Equal(t, first.ID, log.AuditLogEntries[0].ID)
+
+	log, err = dg.GuildAuditLog(guild.ID, "", "", 0, 1)
+	require.NoError(t, err)
+	require.Len(t, log.AuditLogEntries, 1)
+	assert.NotEqual(t, second.ID, log.AuditLogEntries[0].ID)
+}
+
+func TestServer_Messages(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	guild := s.AddGuild("Test Guild")
+	channel := s.AddChannel(guild.ID, "chat", discordgo.ChannelTypeGuildText, "")
+
+	_, err := dg.ChannelMessageSendComplex(channel.ID, &discordgo.MessageSend{})
+	assert.Equal(t, discordgo.ErrCodeCannotSendEmptyMessage, restErrorCode(t, err))
+
+	message, err := dg.ChannelMessageSend(channel.ID, "hello")
+	require.NoError(t, err)
+	assert.Equal(t, s.BotUser.ID, message.Author.ID)
+
+	edited, err := dg.ChannelMessageEdit(channel.ID, message.ID, "hello again")
+	require.NoError(t, err)
+	assert.Equal(t, "hello again", edited.Content)
+	assert.NotNil(t, edited.EditedTimestamp)
+
+	s.DeleteMessage(channel.ID, message.ID)
+	_, err = dg.ChannelMessage(channel.ID, message.ID)
+	assert.Equal(t, discordgo.ErrCodeUnknownMessage, restErrorCode(t, err))
+}
+
+func TestServer_InvitesAndWebhooks(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	guild := s.AddGuild("Test Guild")
+	channel := s.AddChannel(guild.ID, "chat", discordgo.ChannelTypeGuildText, "")
+
+	invite, err := dg.ChannelInviteCreate(channel.ID, discordgo.Invite{MaxAge: 3600})
+	require.NoError(t, err)
+	assert.NotEmpty(t, invite.Code)
+	assert.NotNil(t, invite.ExpiresAt)
+
+	again, err := dg.ChannelInviteCreate(channel.ID, discordgo.Invite{MaxAge: 3600})
+	require.NoError(t, err)
+	assert.Equal(t, invite.Code, again.Code, "an invite with the same settings is reused unless unique is set")
+
+	used := s.AddInvite(channel.ID, discordgo.Invite{MaxUses: 5, Uses: 5})
+	require.NotNil(t, used)
+	_, err = dg.Invite(used.Code)
+	assert.Equal(t, discordgo.ErrCodeUnknownInvite, restErrorCode(t, err), "used up invites no longer resolve")
+
+	old := s.AddInvite(channel.ID, discordgo.Invite{Code: "old", MaxAge: 60, CreatedAt: time.Now().Add(-time.Hour)})
+	require.NotNil(t, old)
+	_, err = dg.Invite(old.Code)
+	assert.Equal(t, discordgo.ErrCodeUnknownInvite, restErrorCode(t, err), "expired invites no longer resolve")
+
+	invites, err := dg.ChannelInvites(channel.ID)
+	require.NoError(t, err)
+	assert.Len(t, invites, 3, "expired invites are still listed")
+
+	withCounts, err := dg.InviteWithCounts(invite.Code)
+	require.NoError(t, err)
+	assert.Equal(t, guild.Name, withCounts.Guild.Name)
+	assert.Equal(t, channel.Name, withCounts.Channel.Name)
+
+	_, err = dg.InviteDelete(invite.Code)
+	require.NoError(t, err)
+	_, err = dg.Invite(invite.Code)
+	assert.Equal(t, discordgo.ErrCodeUnknownInvite, restErrorCode(t, err))
+
+	_, err = dg.WebhookCreate(channel.ID, "clyde", "")
+	assert.Equal(t, discordgo.ErrCodeInvalidFormBody, restErrorCode(t, err))
+
+	webhook, err := dg.WebhookCreate(channel.ID, "alerts", "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, webhook.Token)
+
+	webhooks, err := dg.GuildWebhooks(guild.ID)
+	require.NoError(t, err)
+	assert.Len(t, webhooks, 1)
+
+	other := s.AddUser("other-bot")
+	integration := s.AddWebhook(channel.ID, discordgo.Webhook{Name: "integration", User: other, ApplicationID: other.ID})
+	require.NotNil(t, integration)
+	assert.NotEmpty(t, integration.Token)
+
+	announcements := s.AddChannel(guild.ID, "announcements", discordgo.ChannelTypeGuildNews, "")
+	follower := s.AddFollowerWebhook(channel.ID, announcements.ID, "Test Guild #announcements")
+	require.NotNil(t, follower)
+	assert.Empty(t, follower.Token)
+
+	body, err := dg.RequestWithBucketID("GET", discordgo.EndpointWebhook(follower.ID), nil, discordgo.EndpointWebhook(follower.ID))
+	require.NoError(t, err)
+	var sourced struct {
+		Type          discordgo.WebhookType `json:"type"`
+		SourceGuild   *discordgo.Guild      `json:"source_guild"`
+		SourceChannel *discordgo.Channel    `json:"source_channel"`
+	}
+	require.NoError(t, json.Unmarshal(body, &sourced))
+	assert.Equal(t, discordgo.WebhookTypeChannelFollower, sourced.Type)
+	require.NotNil(t, sourced.SourceChannel)
+	assert.Equal(t, announcements.ID, sourced.SourceChannel.ID)
+	require.NotNil(t, sourced.SourceGuild)
+	assert.Equal(t, guild.ID, sourced.SourceGuild.ID)
+
+	webhooks, err = dg.ChannelWebhooks(channel.ID)
+	require.NoError(t, err)
+	assert.Len(t, webhooks, 3)
+
+	s.DeleteChannel(channel.ID)
+	_, err = dg.Webhook(webhook.ID)
+	assert.Equal(t, discordgo.ErrCodeUnknownWebhook, restErrorCode(t, err))
+}
+
+func TestServer_RateLimitHeaders(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	guild := s.AddGuild("Test Guild")
+
+	resp, err := dg.Client.Get(discordgo.EndpointGuild(guild.ID))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "requests without a token are rejected")
+	assert.NotEmpty(t, resp.Header.Get("X-RateLimit-Bucket"))
+	assert.NotEmpty(t, resp.Header.Get("X-RateLimit-Remaining"))
+	assert.NotEmpty(t, resp.Header.Get("X-RateLimit-Reset-After"))
+
+	s.RateLimitNext(1)
+	_, err = dg.Guild(guild.ID)
+	require.Error(t, err)
+
+	var rateLimitErr *discordgo.RateLimitError
+	assert.True(t, errors.As(err, &rateLimitErr), "expected a rate limit error, got %v", err)
+
+	_, err = dg.Guild(guild.ID)
+	assert.NoError(t, err)
+
+	assert.Contains(t, s.Requests(), "GET /guilds/"+guild.ID)
+}
+
+func TestServer_Latency(t *testing.T) {
+	s, dg := newTestSession(t, Token)
+	guild := s.AddGuild("Test Guild")
+
+	s.SetLatency(time.Second)
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	_, err := dg.Guild(guild.ID, discordgo.WithContext(ctx))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	s.SetLatency(0)
+	_, err = dg.Guild(guild.ID)
+	assert.NoError(t, err)
+}
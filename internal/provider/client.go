@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// defaultAPIURL is the Discord REST API base URL without the version.
+	defaultAPIURL = "https://discord.com/api"
+	// defaultRequestTimeout matches the timeout discordgo sets on its HTTP client.
+	defaultRequestTimeout = 20 * time.Second
+)
+
+// clientOptions are the HTTP client settings from the provider configuration.
+type clientOptions struct {
+	apiURL          *url.URL
+	apiVersion      string
+	httpProxy       *url.URL
+	requestTimeout  time.Duration
+	userAgentSuffix string
+	maxRetries      int
+	maxBackoff      time.Duration
+	// token is the bot token, masked in log output
+	token string
+}
+
+// stringConfigValue returns the configured value, or the environment variable when it is not set.
+func stringConfigValue(value types.String, envVar string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+	return os.Getenv(envVar)
+}
+
+// clientOptionsFromConfig reads and validates the HTTP client settings, falling back to environment variables.
+func clientOptionsFromConfig(config discordProviderModel) (clientOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	opts := clientOptions{
+		apiVersion:     discordgo.APIVersion,
+		requestTimeout: defaultRequestTimeout,
+		maxRetries:     defaultMaxRetries,
+		maxBackoff:     defaultMaxBackoff,
+	}
+
+	apiURL := stringConfigValue(config.APIURL, "DISCORD_API_URL")
+	if apiURL == "" {
+		apiURL = defaultAPIURL
+	}
+	parsedAPIURL, err := url.Parse(apiURL)
+	if err != nil || (parsedAPIURL.Scheme != "http" && parsedAPIURL.Scheme != "https") || parsedAPIURL.Host == "" {
+		diags.AddAttributeError(
+			path.Root("api_url"),
+			"Invalid API URL",
+			fmt.Sprintf("api_url must be an absolute http or https URL such as %q, got %q.", defaultAPIURL, apiURL),
+		)
+	} else {
+		opts.apiURL = parsedAPIURL
+	}
+
+	if apiVersion := stringConfigValue(config.APIVersion, "DISCORD_API_VERSION"); apiVersion != "" {
+		if version, err := strconv.Atoi(apiVersion); err != nil || version <= 0 {
+			diags.AddAttributeError(
+				path.Root("api_version"),
+				"Invalid API Version",
+				fmt.Sprintf("api_version must be a positive number such as \"10\", got %q.", apiVersion),
+			)
+		} else {
+			opts.apiVersion = apiVersion
+		}
+	}
+
+	if httpProxy := stringConfigValue(config.HTTPProxy, "DISCORD_HTTP_PROXY"); httpProxy != "" {
+		parsedProxy, err := url.Parse(httpProxy)
+		if err != nil || parsedProxy.Scheme == "" || parsedProxy.Host == "" {
+			diags.AddAttributeError(
+				path.Root("http_proxy"),
+				"Invalid HTTP Proxy",
+				fmt.Sprintf("http_proxy must be an absolute URL such as \"http://proxy.example.com:3128\", got %q.", httpProxy),
+			)
+		} else {
+			opts.httpProxy = parsedProxy
+		}
+	}
+
+	if requestTimeout := stringConfigValue(config.RequestTimeout, "DISCORD_REQUEST_TIMEOUT"); requestTimeout != "" {
+		parsed, err := time.ParseDuration(requestTimeout)
+		if err != nil || parsed <= 0 {
+			diags.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("request_timeout must be a positive duration such as \"20s\", got %q.", requestTimeout),
+			)
+		} else {
+			opts.requestTimeout = parsed
+		}
+	}
+
+	opts.userAgentSuffix = stringConfigValue(config.UserAgentSuffix, "DISCORD_USER_AGENT_SUFFIX")
+
+	if !config.MaxRetries.IsNull() {
+		if config.MaxRetries.ValueInt64() < 0 {
+			diags.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Max Retries",
+				"max_retries must be 0 or greater.",
+			)
+		} else {
+			opts.maxRetries = int(config.MaxRetries.ValueInt64())
+		}
+	}
+
+	if !config.MaxBackoff.IsNull() {
+		parsed, err := time.ParseDuration(config.MaxBackoff.ValueString())
+		if err != nil || parsed <= 0 {
+			diags.AddAttributeError(
+				path.Root("max_backoff"),
+				"Invalid Max Backoff",
+				fmt.Sprintf("max_backoff must be a positive duration such as \"30s\", got %q.", config.MaxBackoff.ValueString()),
+			)
+		} else {
+			opts.maxBackoff = parsed
+		}
+	}
+
+	return opts, diags
+}
+
+// newHTTPClient builds the HTTP client shared by every request of a provider instance.
+func newHTTPClient(opts clientOptions) *http.Client {
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.httpProxy != nil {
+		base.Proxy = http.ProxyURL(opts.httpProxy)
+	}
+
+	apiBase := strings.TrimSuffix(opts.apiURL.String(), "/") + "/v" + opts.apiVersion + "/"
+
+	transport := newRateLimitTransport(newEndpointTransport(base, discordgo.EndpointAPI, apiBase), opts.maxRetries, opts.maxBackoff)
+	transport.token = opts.token
+	// The timeout applies to each attempt rather than the whole call, which an http.Client timeout would
+	// cover, so a long Retry-After wait does not use it up.
+	transport.requestTimeout = opts.requestTimeout
+
+	return &http.Client{Transport: transport}
+}
+
+// endpointTransport rewrites requests for discordgo's built-in API base URL to the configured one. discordgo
+// builds URLs from package-level variables that every provider alias in the process shares, so the base URL
+// and API version are applied per client here instead.
+type endpointTransport struct {
+	base http.RoundTripper
+	from string
+	to   string
+}
+
+// newEndpointTransport returns a transport that sends requests for URLs starting with from to to instead.
+func newEndpointTransport(base http.RoundTripper, from, to string) http.RoundTripper {
+	if from == to {
+		return base
+	}
+	return &endpointTransport{base: base, from: from, to: to}
+}
+
+// RoundTrip rewrites the request URL if it targets the default API base URL.
+func (t *endpointTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	current := req.URL.String()
+	if !strings.HasPrefix(current, t.from) {
+		return t.base.RoundTrip(req)
+	}
+
+	rewritten, err := url.Parse(t.to + strings.TrimPrefix(current, t.from))
+	if err != nil {
+		return nil, err
+	}
+
+	out := req.Clone(req.Context())
+	out.URL = rewritten
+	out.Host = rewritten.Host
+	return t.base.RoundTrip(out)
+}
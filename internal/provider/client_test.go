@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientOptionsFromConfig_Defaults(t *testing.T) {
+	t.Setenv("DISCORD_API_URL", "")
+	t.Setenv("DISCORD_API_VERSION", "")
+	t.Setenv("DISCORD_HTTP_PROXY", "")
+	t.Setenv("DISCORD_REQUEST_TIMEOUT", "")
+	t.Setenv("DISCORD_USER_AGENT_SUFFIX", "")
+
+	opts, diags := clientOptionsFromConfig(discordProviderModel{})
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, defaultAPIURL, opts.apiURL.String())
+	assert.Equal(t, discordgo.APIVersion, opts.apiVersion)
+	assert.Nil(t, opts.httpProxy)
+	assert.Equal(t, defaultRequestTimeout, opts.requestTimeout)
+	assert.Equal(t, defaultMaxRetries, opts.maxRetries)
+	assert.Equal(t, defaultMaxBackoff, opts.maxBackoff)
+}
+
+func TestClientOptionsFromConfig_EnvironmentFallback(t *testing.T) {
+	t.Setenv("DISCORD_API_URL", "http://127.0.0.1:8080/api")
+	t.Setenv("DISCORD_API_VERSION", "10")
+	t.Setenv("DISCORD_HTTP_PROXY", "http://proxy.example.com:3128")
+	t.Setenv("DISCORD_REQUEST_TIMEOUT", "5s")
+	t.Setenv("DISCORD_USER_AGENT_SUFFIX", "ci")
+
+	opts, diags := clientOptionsFromConfig(discordProviderModel{
+		APIVersion: types.StringValue("9"),
+	})
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "http://127.0.0.1:8080/api", opts.apiURL.String())
+	// The configuration takes precedence over the environment
+	assert.Equal(t, "9", opts.apiVersion)
+	assert.Equal(t, "proxy.example.com:3128", opts.httpProxy.Host)
+	assert.Equal(t, 5*time.Second, opts.requestTimeout)
+	assert.Equal(t, "ci", opts.userAgentSuffix)
+}
+
+func TestClientOptionsFromConfig_Invalid(t *testing.T) {
+	_, diags := clientOptionsFromConfig(discordProviderModel{
+		APIURL:         types.StringValue("discord.com"),
+		APIVersion:     types.StringValue("v10"),
+		RequestTimeout: types.StringValue("soon"),
+		MaxRetries:     types.Int64Value(-1),
+		MaxBackoff:     types.StringValue("0s"),
+	})
+
+	assert.Equal(t, 5, diags.ErrorsCount())
+}
+
+func TestEndpointTransport(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	opts, diags := clientOptionsFromConfig(discordProviderModel{
+		APIURL:     types.StringValue(server.URL + "/api"),
+		APIVersion: types.StringValue("10"),
+	})
+	require.False(t, diags.HasError())
+
+	resp, err := newHTTPClient(opts).Get(discordgo.EndpointUser("@me"))
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "/api/v10/users/@me", gotPath)
+}
+
+func TestNewHTTPClient_RequestTimeoutAppliesPerAttempt(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0.3")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The Retry-After wait is longer than request_timeout, but each attempt finishes well within it
+	opts, diags := clientOptionsFromConfig(discordProviderModel{
+		APIURL:         types.StringValue(server.URL),
+		RequestTimeout: types.StringValue("100ms"),
+	})
+	require.False(t, diags.HasError())
+
+	resp, err := newHTTPClient(opts).Get(discordgo.EndpointUser("@me"))
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestNewHTTPClient_RequestTimeoutBoundsSlowAttempt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	opts, diags := clientOptionsFromConfig(discordProviderModel{
+		APIURL:         types.StringValue(server.URL),
+		MaxRetries:     types.Int64Value(0),
+		RequestTimeout: types.StringValue("50ms"),
+	})
+	require.False(t, diags.HasError())
+
+	start := time.Now()
+	_, err := newHTTPClient(opts).Get(discordgo.EndpointUser("@me"))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
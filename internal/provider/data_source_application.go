@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Application flags that enable privileged gateway intents. The _LIMITED variants are set instead of the full
+// ones for unverified applications in fewer than 100 guilds.
+const (
+	applicationFlagGatewayPresence              = 1 << 12
+	applicationFlagGatewayPresenceLimited       = 1 << 13
+	applicationFlagGatewayGuildMembers          = 1 << 14
+	applicationFlagGatewayGuildMembersLimited   = 1 << 15
+	applicationFlagGatewayMessageContent        = 1 << 18
+	applicationFlagGatewayMessageContentLimited = 1 << 19
+)
+
+// applicationGatewayIntents are the privileged gateway intents in the order they are reported, with the
+// application flags that enable each.
+var applicationGatewayIntents = []struct {
+	name  string
+	flags int
+}{
+	{"GUILD_PRESENCES", applicationFlagGatewayPresence | applicationFlagGatewayPresenceLimited},
+	{"GUILD_MEMBERS", applicationFlagGatewayGuildMembers | applicationFlagGatewayGuildMembersLimited},
+	{"MESSAGE_CONTENT", applicationFlagGatewayMessageContent | applicationFlagGatewayMessageContentLimited},
+}
+
+// Ensure the data source type implements the required interfaces.
+var _ datasource.DataSource = &applicationDataSource{}
+
+// applicationDataSource defines the data source implementation.
+type applicationDataSource struct {
+	client *discordgo.Session
+}
+
+// applicationDataSourceModel describes the data source data model.
+type applicationDataSourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Description              types.String `tfsdk:"description"`
+	Icon                     types.String `tfsdk:"icon"`
+	OwnerID                  types.String `tfsdk:"owner_id"`
+	TeamID                   types.String `tfsdk:"team_id"`
+	BotPublic                types.Bool   `tfsdk:"bot_public"`
+	BotRequireCodeGrant      types.Bool   `tfsdk:"bot_require_code_grant"`
+	Flags                    types.Int64  `tfsdk:"flags"`
+	GatewayIntents           types.List   `tfsdk:"gateway_intents"`
+	InstallParamsScopes      types.List   `tfsdk:"install_params_scopes"`
+	InstallParamsPermissions types.Int64  `tfsdk:"install_params_permissions"`
+	ApproximateGuildCount    types.Int64  `tfsdk:"approximate_guild_count"`
+}
+
+// discordApplication is an application as GET /applications/@me returns it. discordgo.Application lacks the
+// install params and the approximate guild count, and discordgo only fetches applications through the OAuth2
+// endpoint, which bot tokens cannot use for the current application.
+type discordApplication struct {
+	discordgo.Application
+	InstallParams *struct {
+		Scopes      []string `json:"scopes"`
+		Permissions string   `json:"permissions"`
+	} `json:"install_params"`
+	ApproximateGuildCount int `json:"approximate_guild_count"`
+}
+
+// NewApplicationDataSource is a helper function to simplify testing.
+func NewApplicationDataSource() datasource.DataSource {
+	return &applicationDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *applicationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application"
+}
+
+// Schema defines the schema for the data source.
+func (d *applicationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the Discord application the provider's bot belongs to, including the privileged gateway intents enabled for it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the application. Application commands are registered under this ID.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the application.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the application.",
+				Computed:    true,
+			},
+			"icon": schema.StringAttribute{
+				Description: "The icon hash of the application, or null if it has none.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The ID of the user who owns the application, or null if unknown.",
+				Computed:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the team that owns the application, or null if it is not owned by a team.",
+				Computed:    true,
+			},
+			"bot_public": schema.BoolAttribute{
+				Description: "Whether anyone can add the bot to a guild. When false, only the owner can.",
+				Computed:    true,
+			},
+			"bot_require_code_grant": schema.BoolAttribute{
+				Description: "Whether adding the bot requires completing the full OAuth2 code grant flow.",
+				Computed:    true,
+			},
+			"flags": schema.Int64Attribute{
+				Description: "The application's flags.",
+				Computed:    true,
+			},
+			"gateway_intents": schema.ListAttribute{
+				Description: "The privileged gateway intents enabled for the application: GUILD_PRESENCES, GUILD_MEMBERS and MESSAGE_CONTENT.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"install_params_scopes": schema.ListAttribute{
+				Description: "The OAuth2 scopes of the application's default in-app authorization link.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"install_params_permissions": schema.Int64Attribute{
+				Description: "The permissions the default in-app authorization link requests for the bot, or null if the link is not configured.",
+				Computed:    true,
+			},
+			"approximate_guild_count": schema.Int64Attribute{
+				Description: "The approximate number of guilds the application has been added to.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure sets up the data source with the provider's configured client.
+func (d *applicationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *applicationDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Ensure client is configured
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	var application discordApplication
+	endpoint := discordgo.EndpointApplication("@me")
+	body, err := d.client.RequestWithBucketID("GET", endpoint, nil, endpoint, discordgo.WithContext(ctx))
+	if err == nil {
+		err = json.Unmarshal(body, &application)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Application",
+			discordErrorDetail("Unable to fetch the bot's application", err),
+		)
+		return
+	}
+
+	data := applicationDataSourceModel{
+		ID:                       types.StringValue(application.ID),
+		Name:                     types.StringValue(application.Name),
+		Description:              types.StringValue(application.Description),
+		Icon:                     stringValueOrNull(application.Icon),
+		OwnerID:                  types.StringNull(),
+		TeamID:                   types.StringNull(),
+		BotPublic:                types.BoolValue(application.BotPublic),
+		BotRequireCodeGrant:      types.BoolValue(application.BotRequireCodeGrant),
+		Flags:                    types.Int64Value(int64(application.Flags)),
+		InstallParamsPermissions: types.Int64Null(),
+		ApproximateGuildCount:    types.Int64Value(int64(application.ApproximateGuildCount)),
+	}
+	if application.Owner != nil {
+		data.OwnerID = types.StringValue(application.Owner.ID)
+	}
+	if application.Team != nil {
+		data.TeamID = types.StringValue(application.Team.ID)
+	}
+
+	scopes := []string{}
+	if application.InstallParams != nil {
+		scopes = application.InstallParams.Scopes
+		permissions, err := strconv.ParseInt(application.InstallParams.Permissions, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Application Response",
+				fmt.Sprintf("Unable to parse install params permissions %q: %s", application.InstallParams.Permissions, err),
+			)
+			return
+		}
+		data.InstallParamsPermissions = types.Int64Value(permissions)
+	}
+
+	scopesValue, diags := types.ListValueFrom(ctx, types.StringType, scopes)
+	resp.Diagnostics.Append(diags...)
+	intentsValue, diags := types.ListValueFrom(ctx, types.StringType, gatewayIntents(application.Flags))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.InstallParamsScopes = scopesValue
+	data.GatewayIntents = intentsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// gatewayIntents returns the privileged gateway intents that the application flags enable.
+func gatewayIntents(flags int) []string {
+	intents := []string{}
+	for _, intent := range applicationGatewayIntents {
+		if flags&intent.flags != 0 {
+			intents = append(intents, intent.name)
+		}
+	}
+	return intents
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicationDataSource_Metadata(t *testing.T) {
+	d := NewApplicationDataSource()
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	d.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_application", resp.TypeName)
+}
+
+func TestApplicationDataSource_Schema(t *testing.T) {
+	d := NewApplicationDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	d.Schema(t.Context(), req, resp)
+
+	assert.NotNil(t, resp.Schema)
+
+	computedAttrs := []string{"id", "name", "bot_public", "flags", "gateway_intents", "install_params_scopes"}
+	for _, attrName := range computedAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsComputed(), "Attribute %s should be computed", attrName)
+	}
+}
+
+func TestApplicationDataSource_Configure(t *testing.T) {
+	tests := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
+			expectError:  false,
+		},
+		{
+			name:          "invalid provider data type",
+			providerData:  "invalid",
+			expectError:   true,
+			errorContains: "Unexpected Data Source Configure Type",
+		},
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &applicationDataSource{}
+			req := datasource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			d.Configure(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+func TestGatewayIntents(t *testing.T) {
+	assert.Empty(t, gatewayIntents(0))
+	assert.Equal(t, []string{"GUILD_MEMBERS"}, gatewayIntents(applicationFlagGatewayGuildMembers))
+	assert.Equal(t, []string{"GUILD_MEMBERS"}, gatewayIntents(applicationFlagGatewayGuildMembersLimited))
+	assert.Equal(t, []string{"GUILD_PRESENCES", "GUILD_MEMBERS", "MESSAGE_CONTENT"},
+		gatewayIntents(applicationFlagGatewayPresence|applicationFlagGatewayGuildMembers|applicationFlagGatewayMessageContentLimited))
+}
+
+func TestAccApplicationDataSource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	s.AddGuild("Test Guild")
+	s.Application.Flags = applicationFlagGatewayGuildMembersLimited | applicationFlagGatewayMessageContent
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + `
+data "discord_application" "bot" {}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_application.bot", "id", s.BotUser.ID),
+					tfresource.TestCheckResourceAttr("data.discord_application.bot", "name", s.Application.Name),
+					tfresource.TestCheckResourceAttr("data.discord_application.bot", "owner_id", s.Application.Owner.ID),
+					tfresource.TestCheckNoResourceAttr("data.discord_application.bot", "team_id"),
+					tfresource.TestCheckResourceAttr("data.discord_application.bot", "bot_public", "true"),
+					tfresource.TestCheckResourceAttr("data.discord_application.bot", "bot_require_code_grant", "false"),
+					tfresource.TestCheckResourceAttr("data.discord_application.bot", "gateway_intents.#", "2"),
+					tfresource.TestCheckResourceAttr("data.discord_application.bot", "gateway_intents.0", "GUILD_MEMBERS"),
+					tfresource.TestCheckResourceAttr("data.discord_application.bot", "gateway_intents.1", "MESSAGE_CONTENT"),
+					tfresource.TestCheckResourceAttr("data.discord_application.bot", "install_params_scopes.#", "2"),
+					tfresource.TestCheckResourceAttr("data.discord_application.bot", "install_params_permissions", "8"),
+					tfresource.TestCheckResourceAttr("data.discord_application.bot", "approximate_guild_count", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,530 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source type implements the required interfaces.
+var _ datasource.DataSource = &auditLogDataSource{}
+
+// auditLogDataSource defines the data source implementation.
+type auditLogDataSource struct {
+	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
+}
+
+// auditLogDataSourceModel describes the data source data model.
+type auditLogDataSourceModel struct {
+	GuildID    types.String `tfsdk:"guild_id"`
+	UserID     types.String `tfsdk:"user_id"`
+	ActionType types.String `tfsdk:"action_type"`
+	TargetID   types.String `tfsdk:"target_id"`
+	Before     types.String `tfsdk:"before"`
+	After      types.String `tfsdk:"after"`
+	Limit      types.Int64  `tfsdk:"limit"`
+	Entries    types.List   `tfsdk:"entries"`
+}
+
+// auditLogChangeAttributeTypes are the attribute types of a change within an audit log entry.
+var auditLogChangeAttributeTypes = map[string]attr.Type{
+	"key":       types.StringType,
+	"old_value": types.StringType,
+	"new_value": types.StringType,
+}
+
+// auditLogEntryAttributeTypes are the attribute types of an entries element.
+var auditLogEntryAttributeTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"action_type": types.StringType,
+	"user_id":     types.StringType,
+	"target_id":   types.StringType,
+	"reason":      types.StringType,
+	"created_at":  types.StringType,
+	"changes":     types.ListType{ElemType: types.ObjectType{AttrTypes: auditLogChangeAttributeTypes}},
+	"options":     types.MapType{ElemType: types.StringType},
+}
+
+// NewAuditLogDataSource is a helper function to simplify testing.
+func NewAuditLogDataSource() datasource.DataSource {
+	return &auditLogDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *auditLogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_log"
+}
+
+// Schema defines the schema for the data source.
+func (d *auditLogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves entries from a Discord guild's (server's) audit log, newest first, optionally filtered by user, action type, target and time range. " +
+			"Pages through the log in batches of 100. Requires the View Audit Log permission.",
+		Attributes: map[string]schema.Attribute{
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the Discord guild (server). Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Only return entries for actions taken by this user.",
+				Optional:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"action_type": schema.StringAttribute{
+				Description: "Only return entries of this action type, by its Discord event name (e.g. ROLE_UPDATE, CHANNEL_CREATE, MEMBER_ROLE_UPDATE).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(auditLogActionNames()...),
+				},
+			},
+			"target_id": schema.StringAttribute{
+				Description: "Only return entries whose target (the role, channel, user, etc. that was changed) has this ID.",
+				Optional:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"before": schema.StringAttribute{
+				Description: "Only return entries older than this audit log entry ID or snowflake.",
+				Optional:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"after": schema.StringAttribute{
+				Description: "Only return entries newer than this audit log entry ID or snowflake.",
+				Optional:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of entries to return. If unset, every matching entry is returned. Discord keeps audit log entries for 45 days.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "List of audit log entries, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the entry.",
+							Computed:    true,
+						},
+						"action_type": schema.StringAttribute{
+							Description: "The Discord event name of the action (e.g. ROLE_UPDATE). Action types the provider does not know are reported by number.",
+							Computed:    true,
+						},
+						"user_id": schema.StringAttribute{
+							Description: "The ID of the user or bot that took the action.",
+							Computed:    true,
+						},
+						"target_id": schema.StringAttribute{
+							Description: "The ID of the affected entity (role, channel, user, webhook, etc.).",
+							Computed:    true,
+						},
+						"reason": schema.StringAttribute{
+							Description: "The reason given for the action, if any.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the action was taken (RFC 3339 timestamp), derived from the entry ID.",
+							Computed:    true,
+						},
+						"changes": schema.ListNestedAttribute{
+							Description: "The changes made to the target. Values are JSON-encoded strings, because a property can hold a string, number, boolean, list or object; use jsondecode() on old_value and new_value.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Description: "The name of the changed property (e.g. name, color, permissions, $add).",
+										Computed:    true,
+									},
+									"old_value": schema.StringAttribute{
+										Description: "The value before the change as a JSON-encoded string, or null. Even a plain string keeps its JSON quotes (e.g. `\"Moderators\"`), so decode it with jsondecode() before using it.",
+										Computed:    true,
+									},
+									"new_value": schema.StringAttribute{
+										Description: "The value after the change as a JSON-encoded string, or null. Even a plain string keeps its JSON quotes (e.g. `\"Moderators\"`), so decode it with jsondecode() before using it.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"options": schema.MapAttribute{
+							Description: "Additional information for some action types, such as channel_id, count, delete_member_days, members_removed, id, type (role or member) and role_name.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets up the data source with the provider's configured client.
+func (d *auditLogDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *auditLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data auditLogDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure client is configured
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	data.GuildID = guildIDOrDefault(data.GuildID, d.defaultGuildID)
+	guildID := data.GuildID.ValueString()
+	if guildID == "" {
+		resp.Diagnostics.AddError(
+			"Missing Guild ID",
+			missingGuildIDDetail,
+		)
+		return
+	}
+
+	// Build the audit log filters
+	filter := auditLogFilter{
+		userID:   data.UserID.ValueString(),
+		targetID: data.TargetID.ValueString(),
+		before:   data.Before.ValueString(),
+		after:    data.After.ValueString(),
+		limit:    int(data.Limit.ValueInt64()),
+	}
+	if actionType := data.ActionType.ValueString(); actionType != "" {
+		filter.actionType = auditLogActionTypes[actionType]
+	}
+
+	entries, err := fetchAuditLogEntries(ctx, d.client, guildID, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Audit Log",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch the audit log of guild %s", guildID), err),
+		)
+		return
+	}
+
+	// Convert the entries to Terraform values
+	entryValues := make([]attr.Value, 0, len(entries))
+	for _, entry := range entries {
+		changeValues, err := auditLogChangeValues(entry)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Encoding Audit Log Change",
+				fmt.Sprintf("Unable to encode the changes of audit log entry %s: %s", entry.ID, err.Error()),
+			)
+			return
+		}
+
+		changes, diags := types.ListValue(types.ObjectType{AttrTypes: auditLogChangeAttributeTypes}, changeValues)
+		resp.Diagnostics.Append(diags...)
+		options, diags := types.MapValueFrom(ctx, types.StringType, auditLogOptions(entry.Options))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		createdAt := types.StringNull()
+		if timestamp, err := discordgo.SnowflakeTimestamp(entry.ID); err == nil {
+			createdAt = types.StringValue(timestamp.UTC().Format(time.RFC3339))
+		}
+
+		entryValue, diags := types.ObjectValue(auditLogEntryAttributeTypes, map[string]attr.Value{
+			"id":          types.StringValue(entry.ID),
+			"action_type": types.StringValue(auditLogActionName(entry.ActionType)),
+			"user_id":     stringValueOrNull(entry.UserID),
+			"target_id":   stringValueOrNull(entry.TargetID),
+			"reason":      stringValueOrNull(entry.Reason),
+			"created_at":  createdAt,
+			"changes":     changes,
+			"options":     options,
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		entryValues = append(entryValues, entryValue)
+	}
+
+	entriesValue, diags := types.ListValue(types.ObjectType{AttrTypes: auditLogEntryAttributeTypes}, entryValues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Entries = entriesValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// auditLogPageSize is the most audit log entries Discord returns per request.
+const auditLogPageSize = 100
+
+// auditLogActionTypes maps Discord's audit log event names to their action types.
+var auditLogActionTypes = map[string]discordgo.AuditLogAction{
+	"GUILD_UPDATE":                                discordgo.AuditLogActionGuildUpdate,
+	"CHANNEL_CREATE":                              discordgo.AuditLogActionChannelCreate,
+	"CHANNEL_UPDATE":                              discordgo.AuditLogActionChannelUpdate,
+	"CHANNEL_DELETE":                              discordgo.AuditLogActionChannelDelete,
+	"CHANNEL_OVERWRITE_CREATE":                    discordgo.AuditLogActionChannelOverwriteCreate,
+	"CHANNEL_OVERWRITE_UPDATE":                    discordgo.AuditLogActionChannelOverwriteUpdate,
+	"CHANNEL_OVERWRITE_DELETE":                    discordgo.AuditLogActionChannelOverwriteDelete,
+	"MEMBER_KICK":                                 discordgo.AuditLogActionMemberKick,
+	"MEMBER_PRUNE":                                discordgo.AuditLogActionMemberPrune,
+	"MEMBER_BAN_ADD":                              discordgo.AuditLogActionMemberBanAdd,
+	"MEMBER_BAN_REMOVE":                           discordgo.AuditLogActionMemberBanRemove,
+	"MEMBER_UPDATE":                               discordgo.AuditLogActionMemberUpdate,
+	"MEMBER_ROLE_UPDATE":                          discordgo.AuditLogActionMemberRoleUpdate,
+	"MEMBER_MOVE":                                 discordgo.AuditLogActionMemberMove,
+	"MEMBER_DISCONNECT":                           discordgo.AuditLogActionMemberDisconnect,
+	"BOT_ADD":                                     discordgo.AuditLogActionBotAdd,
+	"ROLE_CREATE":                                 discordgo.AuditLogActionRoleCreate,
+	"ROLE_UPDATE":                                 discordgo.AuditLogActionRoleUpdate,
+	"ROLE_DELETE":                                 discordgo.AuditLogActionRoleDelete,
+	"INVITE_CREATE":                               discordgo.AuditLogActionInviteCreate,
+	"INVITE_UPDATE":                               discordgo.AuditLogActionInviteUpdate,
+	"INVITE_DELETE":                               discordgo.AuditLogActionInviteDelete,
+	"WEBHOOK_CREATE":                              discordgo.AuditLogActionWebhookCreate,
+	"WEBHOOK_UPDATE":                              discordgo.AuditLogActionWebhookUpdate,
+	"WEBHOOK_DELETE":                              discordgo.AuditLogActionWebhookDelete,
+	"EMOJI_CREATE":                                discordgo.AuditLogActionEmojiCreate,
+	"EMOJI_UPDATE":                                discordgo.AuditLogActionEmojiUpdate,
+	"EMOJI_DELETE":                                discordgo.AuditLogActionEmojiDelete,
+	"MESSAGE_DELETE":                              discordgo.AuditLogActionMessageDelete,
+	"MESSAGE_BULK_DELETE":                         discordgo.AuditLogActionMessageBulkDelete,
+	"MESSAGE_PIN":                                 discordgo.AuditLogActionMessagePin,
+	"MESSAGE_UNPIN":                               discordgo.AuditLogActionMessageUnpin,
+	"INTEGRATION_CREATE":                          discordgo.AuditLogActionIntegrationCreate,
+	"INTEGRATION_UPDATE":                          discordgo.AuditLogActionIntegrationUpdate,
+	"INTEGRATION_DELETE":                          discordgo.AuditLogActionIntegrationDelete,
+	"STAGE_INSTANCE_CREATE":                       discordgo.AuditLogActionStageInstanceCreate,
+	"STAGE_INSTANCE_UPDATE":                       discordgo.AuditLogActionStageInstanceUpdate,
+	"STAGE_INSTANCE_DELETE":                       discordgo.AuditLogActionStageInstanceDelete,
+	"STICKER_CREATE":                              discordgo.AuditLogActionStickerCreate,
+	"STICKER_UPDATE":                              discordgo.AuditLogActionStickerUpdate,
+	"STICKER_DELETE":                              discordgo.AuditLogActionStickerDelete,
+	"GUILD_SCHEDULED_EVENT_CREATE":                discordgo.AuditLogGuildScheduledEventCreate,
+	"GUILD_SCHEDULED_EVENT_UPDATE":                discordgo.AuditLogGuildScheduledEventUpdate,
+	"GUILD_SCHEDULED_EVENT_DELETE":                discordgo.AuditLogGuildScheduledEventDelete,
+	"THREAD_CREATE":                               discordgo.AuditLogActionThreadCreate,
+	"THREAD_UPDATE":                               discordgo.AuditLogActionThreadUpdate,
+	"THREAD_DELETE":                               discordgo.AuditLogActionThreadDelete,
+	"APPLICATION_COMMAND_PERMISSION_UPDATE":       discordgo.AuditLogActionApplicationCommandPermissionUpdate,
+	"AUTO_MODERATION_RULE_CREATE":                 discordgo.AuditLogActionAutoModerationRuleCreate,
+	"AUTO_MODERATION_RULE_UPDATE":                 discordgo.AuditLogActionAutoModerationRuleUpdate,
+	"AUTO_MODERATION_RULE_DELETE":                 discordgo.AuditLogActionAutoModerationRuleDelete,
+	"AUTO_MODERATION_BLOCK_MESSAGE":               discordgo.AuditLogActionAutoModerationBlockMessage,
+	"AUTO_MODERATION_FLAG_TO_CHANNEL":             discordgo.AuditLogActionAutoModerationFlagToChannel,
+	"AUTO_MODERATION_USER_COMMUNICATION_DISABLED": discordgo.AuditLogActionAutoModerationUserCommunicationDisabled,
+	"CREATOR_MONETIZATION_REQUEST_CREATED":        discordgo.AuditLogActionCreatorMonetizationRequestCreated,
+	"CREATOR_MONETIZATION_TERMS_ACCEPTED":         discordgo.AuditLogActionCreatorMonetizationTermsAccepted,
+}
+
+// auditLogActionNames returns the names of every known audit log action type, sorted.
+func auditLogActionNames() []string {
+	names := make([]string, 0, len(auditLogActionTypes))
+	for name := range auditLogActionTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// auditLogActionName returns the event name of an action type, or its number if the provider does not know it.
+func auditLogActionName(action *discordgo.AuditLogAction) string {
+	if action == nil {
+		return ""
+	}
+	for name, known := range auditLogActionTypes {
+		if known == *action {
+			return name
+		}
+	}
+	return strconv.Itoa(int(*action))
+}
+
+// auditLogFilter holds the audit log filters of the data source.
+type auditLogFilter struct {
+	userID     string
+	actionType discordgo.AuditLogAction
+	targetID   string
+	before     string
+	after      string
+	limit      int
+}
+
+// fetchAuditLogEntries pages backwards through a guild's audit log, newest entry first, and returns the entries
+// that match filter. Paging stops at the after cursor, at the end of the log or once limit entries matched.
+func fetchAuditLogEntries(ctx context.Context, client *discordgo.Session, guildID string, filter auditLogFilter) ([]*discordgo.AuditLogEntry, error) {
+	entries := make([]*discordgo.AuditLogEntry, 0)
+	before := filter.before
+
+	for {
+		page, err := client.GuildAuditLog(guildID, filter.userID, before, int(filter.actionType), auditLogPageSize, discordgo.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range page.AuditLogEntries {
+			if filter.after != "" && !snowflakeLess(filter.after, entry.ID) {
+				return entries, nil
+			}
+			if filter.targetID != "" && entry.TargetID != filter.targetID {
+				continue
+			}
+
+			entries = append(entries, entry)
+			if filter.limit > 0 && len(entries) == filter.limit {
+				return entries, nil
+			}
+		}
+
+		if len(page.AuditLogEntries) < auditLogPageSize {
+			return entries, nil
+		}
+		before = page.AuditLogEntries[len(page.AuditLogEntries)-1].ID
+	}
+}
+
+// stringValueOrNull returns value as a Terraform string, or null if it is empty.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// snowflakeLess reports whether snowflake a is older than snowflake b.
+func snowflakeLess(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+// auditLogOptions flattens the optional entry info of an audit log entry into a map of its set fields.
+func auditLogOptions(options *discordgo.AuditLogOptions) map[string]string {
+	result := make(map[string]string)
+	if options == nil {
+		return result
+	}
+
+	for key, value := range map[string]string{
+		"delete_member_days":                options.DeleteMemberDays,
+		"members_removed":                   options.MembersRemoved,
+		"channel_id":                        options.ChannelID,
+		"message_id":                        options.MessageID,
+		"count":                             options.Count,
+		"id":                                options.ID,
+		"role_name":                         options.RoleName,
+		"application_id":                    options.ApplicationID,
+		"auto_moderation_rule_name":         options.AutoModerationRuleName,
+		"auto_moderation_rule_trigger_type": options.AutoModerationRuleTriggerType,
+		"integration_type":                  options.IntegrationType,
+	} {
+		if value != "" {
+			result[key] = value
+		}
+	}
+
+	if options.Type != nil {
+		switch *options.Type {
+		case discordgo.AuditLogOptionsTypeRole:
+			result["type"] = "role"
+		case discordgo.AuditLogOptionsTypeMember:
+			result["type"] = "member"
+		default:
+			result["type"] = string(*options.Type)
+		}
+	}
+
+	return result
+}
+
+// auditLogChangeValues converts the changes of an audit log entry to Terraform values.
+func auditLogChangeValues(entry *discordgo.AuditLogEntry) ([]attr.Value, error) {
+	values := make([]attr.Value, 0, len(entry.Changes))
+	for _, change := range entry.Changes {
+		key := ""
+		if change.Key != nil {
+			key = string(*change.Key)
+		}
+		oldValue, err := auditLogChangeValue(change.OldValue)
+		if err != nil {
+			return nil, fmt.Errorf("old value of %s: %w", key, err)
+		}
+		newValue, err := auditLogChangeValue(change.NewValue)
+		if err != nil {
+			return nil, fmt.Errorf("new value of %s: %w", key, err)
+		}
+		values = append(values, types.ObjectValueMust(auditLogChangeAttributeTypes, map[string]attr.Value{
+			"key":       types.StringValue(key),
+			"old_value": oldValue,
+			"new_value": newValue,
+		}))
+	}
+	return values, nil
+}
+
+// auditLogChangeValue JSON-encodes an old or new value of an audit log change, or returns null if it is absent.
+func auditLogChangeValue(value interface{}) (types.String, error) {
+	if value == nil {
+		return types.StringNull(), nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(string(encoded)), nil
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogDataSource_Metadata(t *testing.T) {
+	d := NewAuditLogDataSource()
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	d.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_audit_log", resp.TypeName)
+}
+
+func TestAuditLogDataSource_Schema(t *testing.T) {
+	d := NewAuditLogDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	d.Schema(t.Context(), req, resp)
+
+	assert.NotNil(t, resp.Schema)
+	assert.Contains(t, resp.Schema.Description, "audit log")
+
+	// Check optional filter attributes
+	optionalAttrs := []string{"guild_id", "user_id", "action_type", "target_id", "before", "after", "limit"}
+	for _, attrName := range optionalAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsOptional(), "Attribute %s should be optional", attrName)
+	}
+
+	entriesAttr, ok := resp.Schema.Attributes["entries"]
+	assert.True(t, ok)
+	assert.True(t, entriesAttr.IsComputed())
+}
+
+func TestAuditLogDataSource_Configure(t *testing.T) {
+	tests := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
+			expectError:  false,
+		},
+		{
+			name:          "invalid provider data type",
+			providerData:  "invalid",
+			expectError:   true,
+			errorContains: "Unexpected Data Source Configure Type",
+		},
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &auditLogDataSource{}
+			req := datasource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			d.Configure(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+func TestAuditLogActionName(t *testing.T) {
+	roleUpdate := discordgo.AuditLogActionRoleUpdate
+	unknown := discordgo.AuditLogAction(999)
+
+	assert.Equal(t, "ROLE_UPDATE", auditLogActionName(&roleUpdate))
+	assert.Equal(t, "999", auditLogActionName(&unknown))
+	assert.Equal(t, "", auditLogActionName(nil))
+	assert.Len(t, auditLogActionNames(), len(auditLogActionTypes))
+}
+
+func TestAuditLogOptions(t *testing.T) {
+	memberType := discordgo.AuditLogOptionsTypeMember
+
+	assert.Empty(t, auditLogOptions(nil))
+	assert.Equal(t, map[string]string{"id": "123", "type": "member"}, auditLogOptions(&discordgo.AuditLogOptions{ID: "123", Type: &memberType}))
+	assert.Equal(t, map[string]string{"channel_id": "456", "count": "3"}, auditLogOptions(&discordgo.AuditLogOptions{ChannelID: "456", Count: "3"}))
+}
+
+func TestAuditLogChangeValue(t *testing.T) {
+	value, err := auditLogChangeValue(nil)
+	require.NoError(t, err)
+	assert.True(t, value.IsNull())
+
+	value, err = auditLogChangeValue("Moderators")
+	require.NoError(t, err)
+	assert.Equal(t, types.StringValue(`"Moderators"`), value)
+
+	value, err = auditLogChangeValue([]interface{}{map[string]interface{}{"id": "1", "name": "Mods"}})
+	require.NoError(t, err)
+	assert.Equal(t, types.StringValue(`[{"id":"1","name":"Mods"}]`), value)
+}
+
+func TestSnowflakeLess(t *testing.T) {
+	assert.True(t, snowflakeLess("99999999999999999", "100000000000000000"))
+	assert.True(t, snowflakeLess("100000000000000001", "100000000000000002"))
+	assert.False(t, snowflakeLess("100000000000000002", "100000000000000002"))
+}
+
+func TestAccAuditLogDataSource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	role := s.AddRole(guild.ID, "Moderators")
+	alice := s.AddUser("alice")
+	s.AddMember(guild.ID, alice)
+
+	// More entries than fit in one page, so the data source has to follow the before cursor
+	channelCreate := discordgo.AuditLogActionChannelCreate
+	for i := 0; i < auditLogPageSize+20; i++ {
+		s.AddAuditLogEntry(guild.ID, discordgo.AuditLogEntry{UserID: s.BotUser.ID, ActionType: &channelCreate})
+	}
+
+	roleUpdate := discordgo.AuditLogActionRoleUpdate
+	nameKey := discordgo.AuditLogChangeKeyName
+	first := s.AddAuditLogEntry(guild.ID, discordgo.AuditLogEntry{
+		UserID:     alice.ID,
+		TargetID:   role.ID,
+		ActionType: &roleUpdate,
+		Reason:     "Renamed for clarity",
+		Changes:    []*discordgo.AuditLogChange{{Key: &nameKey, OldValue: "Mods", NewValue: "Moderators"}},
+	})
+	s.AddAuditLogEntry(guild.ID, discordgo.AuditLogEntry{UserID: alice.ID, TargetID: guild.ID, ActionType: &roleUpdate})
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "discord_audit_log" "all" {
+  guild_id = %[1]q
+}
+
+data "discord_audit_log" "limited" {
+  guild_id = %[1]q
+  limit    = 110
+}
+
+data "discord_audit_log" "role" {
+  guild_id    = %[1]q
+  user_id     = %[2]q
+  action_type = "ROLE_UPDATE"
+  target_id   = %[3]q
+}
+
+data "discord_audit_log" "after" {
+  guild_id = %[1]q
+  after    = %[4]q
+}
+`, guild.ID, alice.ID, role.ID, first.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_audit_log.all", "entries.#", fmt.Sprint(auditLogPageSize+22)),
+					tfresource.TestCheckResourceAttr("data.discord_audit_log.limited", "entries.#", "110"),
+					tfresource.TestCheckResourceAttr("data.discord_audit_log.role", "entries.#", "1"),
+					tfresource.TestCheckResourceAttr("data.discord_audit_log.role", "entries.0.id", first.ID),
+					tfresource.TestCheckResourceAttr("data.discord_audit_log.role", "entries.0.action_type", "ROLE_UPDATE"),
+					tfresource.TestCheckResourceAttr("data.discord_audit_log.role", "entries.0.user_id", alice.ID),
+					tfresource.TestCheckResourceAttr("data.discord_audit_log.role", "entries.0.reason", "Renamed for clarity"),
+					tfresource.TestCheckResourceAttr("data.discord_audit_log.role", "entries.0.changes.0.key", "name"),
+					tfresource.TestCheckResourceAttr("data.discord_audit_log.role", "entries.0.changes.0.old_value", `"Mods"`),
+					tfresource.TestCheckResourceAttr("data.discord_audit_log.role", "entries.0.changes.0.new_value", `"Moderators"`),
+					tfresource.TestCheckResourceAttrSet("data.discord_audit_log.role", "entries.0.created_at"),
+					tfresource.TestCheckResourceAttr("data.discord_audit_log.after", "entries.#", "1"),
+				),
+			},
+		},
+	})
+}
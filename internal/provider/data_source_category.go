@@ -16,6 +16,8 @@ var _ datasource.DataSource = &categoryDataSource{}
 // categoryDataSource defines the data source implementation.
 type categoryDataSource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // categoryDataSourceModel describes the data source data model.
@@ -51,8 +53,9 @@ func (d *categoryDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Optional:    true,
 			},
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the guild (server) where the category is located. Must be provided along with name if category_id is not specified.",
+				Description: "The ID of the guild (server) where the category is located. Must be provided along with name if category_id is not specified. Defaults to the provider's guild_id.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"id": schema.StringAttribute{
 				Description: "The ID of the category channel.",
@@ -72,16 +75,17 @@ func (d *categoryDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -108,16 +112,17 @@ func (d *categoryDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	categoryID := data.CategoryID.ValueString()
 	name := data.Name.ValueString()
+	data.GuildID = guildIDOrDefault(data.GuildID, d.defaultGuildID)
 	guildID := data.GuildID.ValueString()
 
 	// Determine lookup method
 	if categoryID != "" {
 		// Lookup by ID
-		channel, err = d.client.Channel(categoryID)
+		channel, err = d.client.Channel(categoryID, discordgo.WithContext(ctx))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Fetching Category",
-				fmt.Sprintf("Unable to fetch category %s: %s", categoryID, err.Error()),
+				discordErrorDetail(fmt.Sprintf("Unable to fetch category %s", categoryID), err),
 			)
 			return
 		}
@@ -132,11 +137,11 @@ func (d *categoryDataSource) Read(ctx context.Context, req datasource.ReadReques
 		}
 	} else if name != "" && guildID != "" {
 		// Lookup by name and guild ID
-		channels, err := d.client.GuildChannels(guildID)
+		channels, err := d.client.GuildChannels(guildID, discordgo.WithContext(ctx))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Fetching Guild Channels",
-				fmt.Sprintf("Unable to fetch channels from guild %s: %s", guildID, err.Error()),
+				discordErrorDetail(fmt.Sprintf("Unable to fetch channels from guild %s", guildID), err),
 			)
 			return
 		}
@@ -161,7 +166,7 @@ func (d *categoryDataSource) Read(ctx context.Context, req datasource.ReadReques
 	} else {
 		resp.Diagnostics.AddError(
 			"Missing Required Attributes",
-			"Either category_id must be provided, or both name and guild_id must be provided.",
+			"Either category_id must be provided, or both name and guild_id must be provided. guild_id defaults to the provider's guild_id.",
 		)
 		return
 	}
@@ -60,8 +60,8 @@ func TestCategoryDataSource_Configure(t *testing.T) {
 		errorContains string
 	}{
 		{
-			name:         "valid discordgo.Session",
-			providerData: &discordgo.Session{},
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
 			expectError:  false,
 		},
 		{
@@ -3,30 +3,60 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the data source type implements the required interfaces.
 var _ datasource.DataSource = &channelDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &channelDataSource{}
 
 // channelDataSource defines the data source implementation.
 type channelDataSource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // channelDataSourceModel describes the data source data model.
 type channelDataSourceModel struct {
-	ChannelID  types.String `tfsdk:"channel_id"`
-	ID         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	Type       types.Int64  `tfsdk:"type"`
-	CategoryID types.String `tfsdk:"category_id"`
-	Position   types.Int64  `tfsdk:"position"`
-	GuildID    types.String `tfsdk:"guild_id"`
+	ChannelID            types.String `tfsdk:"channel_id"`
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Type                 types.Int64  `tfsdk:"type"`
+	CategoryID           types.String `tfsdk:"category_id"`
+	Position             types.Int64  `tfsdk:"position"`
+	GuildID              types.String `tfsdk:"guild_id"`
+	Topic                types.String `tfsdk:"topic"`
+	NSFW                 types.Bool   `tfsdk:"nsfw"`
+	Bitrate              types.Int64  `tfsdk:"bitrate"`
+	PermissionOverwrites types.List   `tfsdk:"permission_overwrites"`
+}
+
+// channelOverwriteModel describes a permission overwrite within the channel data source.
+type channelOverwriteModel struct {
+	ID    types.String `tfsdk:"id"`
+	Type  types.String `tfsdk:"type"`
+	Allow types.Int64  `tfsdk:"allow"`
+	Deny  types.Int64  `tfsdk:"deny"`
+}
+
+// channelOverwriteAttributeTypes are the attribute types of a permission_overwrites element.
+var channelOverwriteAttributeTypes = map[string]attr.Type{
+	"id":    types.StringType,
+	"type":  types.StringType,
+	"allow": types.Int64Type,
+	"deny":  types.Int64Type,
 }
 
 // NewChannelDataSource is a helper function to simplify testing.
@@ -42,56 +72,124 @@ func (d *channelDataSource) Metadata(_ context.Context, req datasource.MetadataR
 // Schema defines the schema for the data source.
 func (d *channelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Retrieves a single Discord channel by its ID.",
+		Description: "Retrieves a single Discord channel. Can be looked up by ID, or by name within a guild, optionally narrowed by type and category.",
 		Attributes: map[string]schema.Attribute{
 			"channel_id": schema.StringAttribute{
-				Description: "The ID of the Discord channel to retrieve.",
-				Required:    true,
+				Description: "The ID of the Discord channel to retrieve. Exactly one of channel_id or name must be provided.",
+				Optional:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"id": schema.StringAttribute{
 				Description: "The ID of the channel.",
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the channel.",
+				Description: "The name of the channel to look up in guild_id. Exactly one of channel_id or name must be provided. The lookup fails if more than one channel matches.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"type": schema.Int64Attribute{
-				Description: "The type of the channel. 0 = text channel, 2 = voice channel, 4 = category channel, etc.",
+				Description: "The type of the channel. 0 = text channel, 2 = voice channel, 4 = category channel, etc. When set with name, only channels of this type match.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("channel_id")),
+				},
 			},
 			"category_id": schema.StringAttribute{
-				Description: "The ID of the parent category channel, if this channel belongs to a category.",
+				Description: "The ID of the parent category channel, if this channel belongs to a category. When set with name, only channels in this category match.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("channel_id")),
+					snowflakeValidator(),
+				},
 			},
 			"position": schema.Int64Attribute{
 				Description: "The position of the channel in the channel list.",
 				Computed:    true,
 			},
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the guild (server) this channel belongs to.",
+				Description: "The ID of the guild (server) this channel belongs to. Used with name to look up the channel. Defaults to the provider's guild_id.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("channel_id")),
+					snowflakeValidator(),
+				},
+			},
+			"topic": schema.StringAttribute{
+				Description: "The topic of the channel, if set.",
+				Computed:    true,
+			},
+			"nsfw": schema.BoolAttribute{
+				Description: "Whether the channel is marked as age-restricted.",
+				Computed:    true,
+			},
+			"bitrate": schema.Int64Attribute{
+				Description: "The bitrate of a voice channel in bits per second. 0 for other channel types.",
+				Computed:    true,
+			},
+			"permission_overwrites": schema.ListNestedAttribute{
+				Description: "The permission overwrites set on the channel.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the role or member the overwrite applies to.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the overwrite: \"role\" or \"member\".",
+							Computed:    true,
+						},
+						"allow": schema.Int64Attribute{
+							Description: "The permission bits explicitly allowed.",
+							Computed:    true,
+						},
+						"deny": schema.Int64Attribute{
+							Description: "The permission bits explicitly denied.",
+							Computed:    true,
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
+// ConfigValidators requires exactly one way of identifying the channel.
+func (d *channelDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("channel_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 // Configure sets up the data source with the provider's configured client.
 func (d *channelDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -113,23 +211,61 @@ func (d *channelDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	channelID := data.ChannelID.ValueString()
-	if channelID == "" {
-		resp.Diagnostics.AddError(
-			"Missing Channel ID",
-			"The channel_id attribute is required.",
-		)
-		return
-	}
+	var channel *discordgo.Channel
 
-	// Fetch the channel by ID
-	channel, err := d.client.Channel(channelID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Fetching Channel",
-			fmt.Sprintf("Unable to fetch channel %s: %s", channelID, err.Error()),
-		)
-		return
+	if channelID := data.ChannelID.ValueString(); channelID != "" {
+		// Lookup by ID
+		var err error
+		channel, err = d.client.Channel(channelID, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Fetching Channel",
+				discordErrorDetail(fmt.Sprintf("Unable to fetch channel %s", channelID), err),
+			)
+			return
+		}
+	} else {
+		// Lookup by name within the guild
+		data.GuildID = guildIDOrDefault(data.GuildID, d.defaultGuildID)
+		guildID := data.GuildID.ValueString()
+		if guildID == "" {
+			resp.Diagnostics.AddError(
+				"Missing Guild ID",
+				missingGuildIDDetail,
+			)
+			return
+		}
+
+		channels, err := d.client.GuildChannels(guildID, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Fetching Guild Channels",
+				discordErrorDetail(fmt.Sprintf("Unable to fetch channels from guild %s", guildID), err),
+			)
+			return
+		}
+
+		matches := matchChannels(channels, data.Name.ValueString(), data.Type, data.CategoryID)
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddError(
+				"Channel Not Found",
+				fmt.Sprintf("No channel found with name '%s' in guild %s matching the given type and category_id.", data.Name.ValueString(), guildID),
+			)
+			return
+		case 1:
+			channel = matches[0]
+		default:
+			ids := make([]string, 0, len(matches))
+			for _, ch := range matches {
+				ids = append(ids, ch.ID)
+			}
+			resp.Diagnostics.AddError(
+				"Multiple Channels Found",
+				fmt.Sprintf("%d channels named '%s' were found in guild %s: %s. Set type or category_id to narrow the lookup, or use channel_id.", len(matches), data.Name.ValueString(), guildID, strings.Join(ids, ", ")),
+			)
+			return
+		}
 	}
 
 	// Populate the model with channel data
@@ -138,6 +274,9 @@ func (d *channelDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	data.Type = types.Int64Value(int64(channel.Type))
 	data.Position = types.Int64Value(int64(channel.Position))
 	data.GuildID = types.StringValue(channel.GuildID)
+	data.Topic = types.StringValue(channel.Topic)
+	data.NSFW = types.BoolValue(channel.NSFW)
+	data.Bitrate = types.Int64Value(int64(channel.Bitrate))
 
 	if channel.ParentID != "" {
 		data.CategoryID = types.StringValue(channel.ParentID)
@@ -145,6 +284,45 @@ func (d *channelDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		data.CategoryID = types.StringNull()
 	}
 
+	overwrites := make([]channelOverwriteModel, 0, len(channel.PermissionOverwrites))
+	for _, ow := range channel.PermissionOverwrites {
+		overwriteType := "role"
+		if ow.Type == discordgo.PermissionOverwriteTypeMember {
+			overwriteType = "member"
+		}
+		overwrites = append(overwrites, channelOverwriteModel{
+			ID:    types.StringValue(ow.ID),
+			Type:  types.StringValue(overwriteType),
+			Allow: types.Int64Value(ow.Allow),
+			Deny:  types.Int64Value(ow.Deny),
+		})
+	}
+
+	overwritesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: channelOverwriteAttributeTypes}, overwrites)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.PermissionOverwrites = overwritesValue
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// matchChannels returns the channels named name, narrowed to channelType and categoryID when they are set.
+func matchChannels(channels []*discordgo.Channel, name string, channelType types.Int64, categoryID types.String) []*discordgo.Channel {
+	matches := make([]*discordgo.Channel, 0, 1)
+	for _, ch := range channels {
+		if ch.Name != name {
+			continue
+		}
+		if !channelType.IsNull() && !channelType.IsUnknown() && int64(ch.Type) != channelType.ValueInt64() {
+			continue
+		}
+		if !categoryID.IsNull() && !categoryID.IsUnknown() && ch.ParentID != categoryID.ValueString() {
+			continue
+		}
+		matches = append(matches, ch)
+	}
+	return matches
+}
@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,15 +32,18 @@ func TestChannelDataSource_Schema(t *testing.T) {
 	ds.Schema(t.Context(), req, resp)
 
 	assert.NotNil(t, resp.Schema)
-	assert.Equal(t, "Retrieves a single Discord channel by its ID.", resp.Schema.Description)
+	assert.Contains(t, resp.Schema.Description, "Retrieves a single Discord channel")
 
-	// Check required attribute
-	channelIDAttr, ok := resp.Schema.Attributes["channel_id"]
-	assert.True(t, ok)
-	assert.True(t, channelIDAttr.IsRequired())
+	// Check lookup attributes
+	lookupAttrs := []string{"channel_id", "name", "type", "category_id", "guild_id"}
+	for _, attrName := range lookupAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsOptional(), "Attribute %s should be optional", attrName)
+	}
 
 	// Check computed attributes
-	computedAttrs := []string{"id", "name", "type", "category_id", "position", "guild_id"}
+	computedAttrs := []string{"id", "name", "type", "category_id", "position", "guild_id", "topic", "nsfw", "bitrate", "permission_overwrites"}
 	for _, attrName := range computedAttrs {
 		attr, ok := resp.Schema.Attributes[attrName]
 		assert.True(t, ok, "Attribute %s should exist", attrName)
@@ -52,8 +59,8 @@ func TestChannelDataSource_Configure(t *testing.T) {
 		errorContains string
 	}{
 		{
-			name:         "valid discordgo.Session",
-			providerData: &discordgo.Session{},
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
 			expectError:  false,
 		},
 		{
@@ -91,6 +98,117 @@ func TestChannelDataSource_Configure(t *testing.T) {
 	}
 }
 
+func TestMatchChannels(t *testing.T) {
+	channels := []*discordgo.Channel{
+		{ID: "1", Name: "general", Type: discordgo.ChannelTypeGuildText},
+		{ID: "2", Name: "general", Type: discordgo.ChannelTypeGuildVoice},
+		{ID: "3", Name: "general", Type: discordgo.ChannelTypeGuildText, ParentID: "10"},
+		{ID: "4", Name: "mod-log", Type: discordgo.ChannelTypeGuildText},
+	}
+
+	ids := func(matches []*discordgo.Channel) []string {
+		result := make([]string, 0, len(matches))
+		for _, ch := range matches {
+			result = append(result, ch.ID)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"1", "2", "3"}, ids(matchChannels(channels, "general", types.Int64Null(), types.StringNull())))
+	assert.Equal(t, []string{"1", "3"}, ids(matchChannels(channels, "general", types.Int64Value(0), types.StringNull())))
+	assert.Equal(t, []string{"3"}, ids(matchChannels(channels, "general", types.Int64Value(0), types.StringValue("10"))))
+	assert.Equal(t, []string{"4"}, ids(matchChannels(channels, "mod-log", types.Int64Null(), types.StringNull())))
+	assert.Empty(t, matchChannels(channels, "rules", types.Int64Null(), types.StringNull()))
+}
+
+func TestAccChannelDataSource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	role := s.AddRole(guild.ID, "Moderators")
+	staff := s.AddChannel(guild.ID, "Staff", discordgo.ChannelTypeGuildCategory, "")
+	general := s.AddChannel(guild.ID, "general", discordgo.ChannelTypeGuildText, "")
+	generalVoice := s.AddChannel(guild.ID, "general", discordgo.ChannelTypeGuildVoice, "")
+	modLog := s.AddChannel(guild.ID, "mod-log", discordgo.ChannelTypeGuildText, staff.ID)
+	s.EditChannel(modLog.ID, func(channel *discordgo.Channel) {
+		channel.Topic = "Moderation actions"
+		channel.NSFW = true
+		channel.PermissionOverwrites = []*discordgo.PermissionOverwrite{
+			{ID: role.ID, Type: discordgo.PermissionOverwriteTypeRole, Allow: 1024, Deny: 2048},
+		}
+	})
+	s.EditChannel(generalVoice.ID, func(channel *discordgo.Channel) { channel.Bitrate = 64000 })
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "discord_channel" "by_id" {
+  channel_id = %q
+}
+
+data "discord_channel" "by_name" {
+  guild_id    = %q
+  name        = "mod-log"
+  category_id = %q
+}
+
+data "discord_channel" "by_type" {
+  guild_id = %q
+  name     = "general"
+  type     = 2
+}
+`, modLog.ID, guild.ID, staff.ID, guild.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_channel.by_id", "name", "mod-log"),
+					tfresource.TestCheckResourceAttr("data.discord_channel.by_id", "category_id", staff.ID),
+					tfresource.TestCheckResourceAttr("data.discord_channel.by_name", "id", modLog.ID),
+					tfresource.TestCheckResourceAttr("data.discord_channel.by_name", "topic", "Moderation actions"),
+					tfresource.TestCheckResourceAttr("data.discord_channel.by_name", "nsfw", "true"),
+					tfresource.TestCheckResourceAttr("data.discord_channel.by_name", "permission_overwrites.#", "1"),
+					tfresource.TestCheckResourceAttr("data.discord_channel.by_name", "permission_overwrites.0.id", role.ID),
+					tfresource.TestCheckResourceAttr("data.discord_channel.by_name", "permission_overwrites.0.type", "role"),
+					tfresource.TestCheckResourceAttr("data.discord_channel.by_name", "permission_overwrites.0.allow", "1024"),
+					tfresource.TestCheckResourceAttr("data.discord_channel.by_name", "permission_overwrites.0.deny", "2048"),
+					tfresource.TestCheckResourceAttr("data.discord_channel.by_type", "id", generalVoice.ID),
+					tfresource.TestCheckResourceAttr("data.discord_channel.by_type", "bitrate", "64000"),
+				),
+			},
+			{
+				// Two channels share the name, so the lookup lists both instead of picking one
+				Config: providerConfig + fmt.Sprintf(`
+data "discord_channel" "test" {
+  guild_id = %q
+  name     = "general"
+}
+`, guild.ID),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`(?s)Multiple Channels Found.*%s.*%s`, general.ID, generalVoice.ID)),
+			},
+		},
+	})
+}
+
+func TestAccChannelDataSource_invalidConfig(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	general := s.AddChannel(guild.ID, "general", discordgo.ChannelTypeGuildText, "")
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "discord_channel" "test" {
+  channel_id = %q
+  name       = "general"
+}
+`, general.ID),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 // Note: Tests for Read() method that require Discord API calls should be
 // implemented as acceptance tests with TF_ACC=1 environment variable set.
 // These unit tests verify the schema, metadata, and configuration validation
@@ -17,6 +17,8 @@ var _ datasource.DataSource = &channelsDataSource{}
 // channelsDataSource defines the data source implementation.
 type channelsDataSource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // channelsDataSourceModel describes the data source data model.
@@ -51,8 +53,9 @@ func (d *channelsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 		Description: "Retrieves channels from a Discord guild (server). Optionally filters channels by category name.",
 		Attributes: map[string]schema.Attribute{
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the Discord guild (server).",
-				Required:    true,
+				Description: "The ID of the Discord guild (server). Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"category_name": schema.StringAttribute{
 				Description: "Optional: Filter channels by category name. If provided, only channels within the specified category will be returned.",
@@ -96,16 +99,17 @@ func (d *channelsDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -127,21 +131,22 @@ func (d *channelsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	data.GuildID = guildIDOrDefault(data.GuildID, d.defaultGuildID)
 	guildID := data.GuildID.ValueString()
 	if guildID == "" {
 		resp.Diagnostics.AddError(
 			"Missing Guild ID",
-			"The guild_id attribute is required.",
+			missingGuildIDDetail,
 		)
 		return
 	}
 
 	// Fetch all channels for the guild
-	channels, err := d.client.GuildChannels(guildID)
+	channels, err := d.client.GuildChannels(guildID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Channels",
-			fmt.Sprintf("Unable to fetch channels for guild %s: %s", guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch channels for guild %s", guildID), err),
 		)
 		return
 	}
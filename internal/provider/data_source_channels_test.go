@@ -33,7 +33,8 @@ func TestChannelsDataSource_Schema(t *testing.T) {
 	// Check required attribute
 	guildIDAttr, ok := resp.Schema.Attributes["guild_id"]
 	assert.True(t, ok)
-	assert.True(t, guildIDAttr.IsRequired())
+	assert.True(t, guildIDAttr.IsOptional(), "guild_id defaults to the provider's guild_id")
+	assert.True(t, guildIDAttr.IsComputed())
 
 	// Check optional attribute
 	categoryNameAttr, ok := resp.Schema.Attributes["category_name"]
@@ -54,8 +55,8 @@ func TestChannelsDataSource_Configure(t *testing.T) {
 		errorContains string
 	}{
 		{
-			name:         "valid discordgo.Session",
-			providerData: &discordgo.Session{},
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
 			expectError:  false,
 		},
 		{
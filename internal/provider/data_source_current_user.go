@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source type implements the required interfaces.
+var _ datasource.DataSource = &currentUserDataSource{}
+
+// currentUserDataSource defines the data source implementation.
+type currentUserDataSource struct {
+	client *discordgo.Session
+}
+
+// currentUserDataSourceModel describes the data source data model.
+type currentUserDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Username      types.String `tfsdk:"username"`
+	GlobalName    types.String `tfsdk:"global_name"`
+	Discriminator types.String `tfsdk:"discriminator"`
+	Avatar        types.String `tfsdk:"avatar"`
+	Bot           types.Bool   `tfsdk:"bot"`
+	System        types.Bool   `tfsdk:"system"`
+	MFAEnabled    types.Bool   `tfsdk:"mfa_enabled"`
+	Verified      types.Bool   `tfsdk:"verified"`
+	Flags         types.Int64  `tfsdk:"flags"`
+	PublicFlags   types.Int64  `tfsdk:"public_flags"`
+}
+
+// NewCurrentUserDataSource is a helper function to simplify testing.
+func NewCurrentUserDataSource() datasource.DataSource {
+	return &currentUserDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *currentUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+// Schema defines the schema for the data source.
+func (d *currentUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the Discord user the provider's bot token authenticates as, for example to grant the bot itself a permission overwrite.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the bot user.",
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the bot user.",
+				Computed:    true,
+			},
+			"global_name": schema.StringAttribute{
+				Description: "The display name of the bot user, or null if it has none.",
+				Computed:    true,
+			},
+			"discriminator": schema.StringAttribute{
+				Description: "The discriminator of the bot user.",
+				Computed:    true,
+			},
+			"avatar": schema.StringAttribute{
+				Description: "The avatar hash of the bot user, or null if it has none.",
+				Computed:    true,
+			},
+			"bot": schema.BoolAttribute{
+				Description: "Whether the user is a bot.",
+				Computed:    true,
+			},
+			"system": schema.BoolAttribute{
+				Description: "Whether the user is an official Discord system user.",
+				Computed:    true,
+			},
+			"mfa_enabled": schema.BoolAttribute{
+				Description: "Whether the user has two factor authentication enabled.",
+				Computed:    true,
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the user's email address has been verified.",
+				Computed:    true,
+			},
+			"flags": schema.Int64Attribute{
+				Description: "The flags on the user's account.",
+				Computed:    true,
+			},
+			"public_flags": schema.Int64Attribute{
+				Description: "The public flags on the user's account, such as the verified bot flag (65536).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure sets up the data source with the provider's configured client.
+func (d *currentUserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *currentUserDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Ensure client is configured
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	user, err := d.client.User("@me", discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Current User",
+			discordErrorDetail("Unable to fetch the bot user", err),
+		)
+		return
+	}
+
+	data := currentUserDataSourceModel{
+		ID:            types.StringValue(user.ID),
+		Username:      types.StringValue(user.Username),
+		GlobalName:    stringValueOrNull(user.GlobalName),
+		Discriminator: types.StringValue(user.Discriminator),
+		Avatar:        stringValueOrNull(user.Avatar),
+		Bot:           types.BoolValue(user.Bot),
+		System:        types.BoolValue(user.System),
+		MFAEnabled:    types.BoolValue(user.MFAEnabled),
+		Verified:      types.BoolValue(user.Verified),
+		Flags:         types.Int64Value(int64(user.Flags)),
+		PublicFlags:   types.Int64Value(int64(user.PublicFlags)),
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrentUserDataSource_Metadata(t *testing.T) {
+	d := NewCurrentUserDataSource()
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	d.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_current_user", resp.TypeName)
+}
+
+func TestCurrentUserDataSource_Schema(t *testing.T) {
+	d := NewCurrentUserDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	d.Schema(t.Context(), req, resp)
+
+	assert.NotNil(t, resp.Schema)
+
+	computedAttrs := []string{"id", "username", "bot", "flags", "public_flags"}
+	for _, attrName := range computedAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsComputed(), "Attribute %s should be computed", attrName)
+	}
+}
+
+func TestCurrentUserDataSource_Configure(t *testing.T) {
+	tests := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
+			expectError:  false,
+		},
+		{
+			name:          "invalid provider data type",
+			providerData:  "invalid",
+			expectError:   true,
+			errorContains: "Unexpected Data Source Configure Type",
+		},
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &currentUserDataSource{}
+			req := datasource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			d.Configure(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+func TestAccCurrentUserDataSource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + `
+data "discord_current_user" "bot" {}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_current_user.bot", "id", s.BotUser.ID),
+					tfresource.TestCheckResourceAttr("data.discord_current_user.bot", "username", s.BotUser.Username),
+					tfresource.TestCheckResourceAttr("data.discord_current_user.bot", "bot", "true"),
+					tfresource.TestCheckNoResourceAttr("data.discord_current_user.bot", "avatar"),
+				),
+			},
+		},
+	})
+}
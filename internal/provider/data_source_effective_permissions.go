@@ -0,0 +1,545 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Sources that decide a permission flag, as reported in explanations.
+const (
+	permissionSourceRole              = "role"
+	permissionSourceOwner             = "owner"
+	permissionSourceAdministrator     = "administrator"
+	permissionSourceEveryoneOverwrite = "everyone_overwrite"
+	permissionSourceRoleOverwrite     = "role_overwrite"
+	permissionSourceMemberOverwrite   = "member_overwrite"
+	// The implicit sources deny permissions that Discord ignores without VIEW_CHANNEL or SEND_MESSAGES.
+	permissionSourceImplicitViewChannel  = "implicit_view_channel"
+	permissionSourceImplicitSendMessages = "implicit_send_messages"
+)
+
+// sendMessagesPermissions are the permissions that Discord ignores in a channel where SEND_MESSAGES is denied.
+const sendMessagesPermissions = discordgo.PermissionMentionEveryone | discordgo.PermissionSendTTSMessages |
+	discordgo.PermissionAttachFiles | discordgo.PermissionEmbedLinks
+
+// Ensure the data source type implements the required interfaces.
+var _ datasource.DataSource = &effectivePermissionsDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &effectivePermissionsDataSource{}
+
+// effectivePermissionsDataSource defines the data source implementation.
+type effectivePermissionsDataSource struct {
+	client *discordgo.Session
+}
+
+// effectivePermissionsDataSourceModel describes the data source data model.
+type effectivePermissionsDataSourceModel struct {
+	GuildID         types.String `tfsdk:"guild_id"`
+	ChannelID       types.String `tfsdk:"channel_id"`
+	UserID          types.String `tfsdk:"user_id"`
+	RoleIDs         types.Set    `tfsdk:"role_ids"`
+	Permissions     types.Int64  `tfsdk:"permissions"`
+	PermissionNames types.List   `tfsdk:"permission_names"`
+	Explanations    types.List   `tfsdk:"explanations"`
+}
+
+// permissionExplanationModel describes which role or overwrite decided a permission flag.
+type permissionExplanationModel struct {
+	Permission types.String `tfsdk:"permission"`
+	Allowed    types.Bool   `tfsdk:"allowed"`
+	Source     types.String `tfsdk:"source"`
+	SourceID   types.String `tfsdk:"source_id"`
+	ChannelID  types.String `tfsdk:"channel_id"`
+}
+
+// permissionExplanationAttributeTypes are the attribute types of an explanations element.
+var permissionExplanationAttributeTypes = map[string]attr.Type{
+	"permission": types.StringType,
+	"allowed":    types.BoolType,
+	"source":     types.StringType,
+	"source_id":  types.StringType,
+	"channel_id": types.StringType,
+}
+
+// permissionContext is everything Discord's permission algorithm looks at for one member, or one set of
+// roles, in one channel.
+type permissionContext struct {
+	guildID string
+	ownerID string
+	// userID is empty when computing the permissions of a set of roles.
+	userID string
+	// everyone is the guild's @everyone role.
+	everyone *discordgo.Role
+	// roles are the other roles held, highest first.
+	roles []*discordgo.Role
+	// categoryOverwrites are the permission overwrites of the channel's category, set when the channel is synced
+	// with it, and categoryID the category.
+	categoryOverwrites []*discordgo.PermissionOverwrite
+	categoryID         string
+	// overwrites are the permission overwrites of the channel, and overwritesChannelID the channel.
+	overwrites          []*discordgo.PermissionOverwrite
+	overwritesChannelID string
+}
+
+// permissionDecision is the role or overwrite that last decided a permission flag.
+type permissionDecision struct {
+	allowed   bool
+	source    string
+	sourceID  string
+	channelID string
+}
+
+// NewEffectivePermissionsDataSource is a helper function to simplify testing.
+func NewEffectivePermissionsDataSource() datasource.DataSource {
+	return &effectivePermissionsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *effectivePermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_permissions"
+}
+
+// Schema defines the schema for the data source.
+func (d *effectivePermissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Computes the effective permissions of a member, or of a set of roles, in a Discord channel using Discord's algorithm: " +
+			"@everyone, then the union of the roles, then the administrator short-circuit, then the @everyone, role and member overwrites " +
+			"of the category when the channel is synced with it, then those of the channel. Without VIEW_CHANNEL no permission applies in " +
+			"the channel, and without SEND_MESSAGES neither do MENTION_EVERYONE, SEND_TTS_MESSAGES, ATTACH_FILES and EMBED_LINKS.",
+		Attributes: map[string]schema.Attribute{
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild (server) the channel belongs to. Defaults to the channel's guild.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the channel to compute permissions in.",
+				Required:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the member to compute permissions for. Exactly one of user_id or role_ids must be provided.",
+				Optional:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"role_ids": schema.SetAttribute{
+				Description: "The IDs of the roles to compute permissions for, as held by a member without member overwrites. @everyone is always included. Exactly one of user_id or role_ids must be provided.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					snowflakeSetValidator(),
+				},
+			},
+			"permissions": schema.Int64Attribute{
+				Description: "The effective permission bitfield in the channel.",
+				Computed:    true,
+			},
+			"permission_names": schema.ListAttribute{
+				Description: "The names of the effective permissions, such as VIEW_CHANNEL, in bit order.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"explanations": schema.ListNestedAttribute{
+				Description: "Which role or overwrite decided each permission that is granted, or that an overwrite denied, in bit order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission": schema.StringAttribute{
+							Description: "The name of the permission.",
+							Computed:    true,
+						},
+						"allowed": schema.BoolAttribute{
+							Description: "Whether the permission is granted.",
+							Computed:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "What decided the permission: role, owner, administrator, everyone_overwrite, role_overwrite, member_overwrite, " +
+								"implicit_view_channel (denied because VIEW_CHANNEL is) or implicit_send_messages (denied because SEND_MESSAGES is).",
+							Computed: true,
+						},
+						"source_id": schema.StringAttribute{
+							Description: "The ID of the role or member that decided the permission: the role granting it or ADMINISTRATOR, the owner, or the target of the overwrite. " +
+								"For an implicit denial, the target of the overwrite that denied VIEW_CHANNEL or SEND_MESSAGES, if any.",
+							Computed: true,
+						},
+						"channel_id": schema.StringAttribute{
+							Description: "For overwrites, the ID of the channel the overwrite is set on. This is the category for an overwrite a synced channel has from it. " +
+								"Null for role, owner and administrator.",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators requires exactly one way of identifying whose permissions to compute.
+func (d *effectivePermissionsDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("user_id"),
+			path.MatchRoot("role_ids"),
+		),
+	}
+}
+
+// Configure sets up the data source with the provider's configured client.
+func (d *effectivePermissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *effectivePermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data effectivePermissionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure client is configured
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	channelID := data.ChannelID.ValueString()
+	channel, err := d.client.Channel(channelID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Channel",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch channel %s", channelID), err),
+		)
+		return
+	}
+
+	guildID := channel.GuildID
+	if !data.GuildID.IsNull() && data.GuildID.ValueString() != guildID {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("channel_id"),
+			"Channel Not In Guild",
+			fmt.Sprintf("Channel %s belongs to guild %s, not guild %s.", channelID, guildID, data.GuildID.ValueString()),
+		)
+		return
+	}
+	data.GuildID = types.StringValue(guildID)
+
+	pc := permissionContext{
+		guildID:             guildID,
+		overwrites:          channel.PermissionOverwrites,
+		overwritesChannelID: channel.ID,
+	}
+
+	// The overwrites of the category apply first when the channel is synced with it
+	if channel.ParentID != "" {
+		category, err := d.client.Channel(channel.ParentID, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Fetching Category",
+				discordErrorDetail(fmt.Sprintf("Unable to fetch category %s of channel %s", channel.ParentID, channelID), err),
+			)
+			return
+		}
+		if overwritesEqual(channel.PermissionOverwrites, category.PermissionOverwrites) {
+			pc.categoryOverwrites = category.PermissionOverwrites
+			pc.categoryID = category.ID
+		}
+	}
+
+	guildRoles, err := d.client.GuildRoles(guildID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Guild Roles",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles from guild %s", guildID), err),
+		)
+		return
+	}
+	rolesByID := make(map[string]*discordgo.Role, len(guildRoles))
+	for _, role := range guildRoles {
+		rolesByID[role.ID] = role
+	}
+	pc.everyone = rolesByID[guildID]
+	if pc.everyone == nil {
+		resp.Diagnostics.AddError(
+			"Missing @everyone Role",
+			fmt.Sprintf("Guild %s returned no @everyone role.", guildID),
+		)
+		return
+	}
+
+	var roleIDs []string
+	if userID := data.UserID.ValueString(); userID != "" {
+		member, err := d.client.GuildMember(guildID, userID, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Fetching Member",
+				discordErrorDetail(fmt.Sprintf("Unable to fetch member %s from guild %s", userID, guildID), err),
+			)
+			return
+		}
+		guild, err := d.client.Guild(guildID, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Fetching Guild",
+				discordErrorDetail(fmt.Sprintf("Unable to fetch guild %s", guildID), err),
+			)
+			return
+		}
+		pc.userID = userID
+		pc.ownerID = guild.OwnerID
+		roleIDs = member.Roles
+	} else {
+		resp.Diagnostics.Append(data.RoleIDs.ElementsAs(ctx, &roleIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for _, roleID := range roleIDs {
+		if roleID == guildID {
+			continue
+		}
+		role, ok := rolesByID[roleID]
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Role Not Found",
+				fmt.Sprintf("Role %s was not found in guild %s.", roleID, guildID),
+			)
+			return
+		}
+		pc.roles = append(pc.roles, role)
+	}
+	sort.SliceStable(pc.roles, func(i, j int) bool {
+		if pc.roles[i].Position != pc.roles[j].Position {
+			return pc.roles[i].Position > pc.roles[j].Position
+		}
+		return snowflakeLess(pc.roles[i].ID, pc.roles[j].ID)
+	})
+
+	permissions, decisions := computeEffectivePermissions(pc)
+
+	explanations := make([]permissionExplanationModel, 0, len(decisions))
+	for _, flag := range permissionFlags {
+		decision, ok := decisions[flag.bit]
+		if !ok {
+			continue
+		}
+		explanations = append(explanations, permissionExplanationModel{
+			Permission: types.StringValue(flag.name),
+			Allowed:    types.BoolValue(decision.allowed),
+			Source:     types.StringValue(decision.source),
+			SourceID:   stringValueOrNull(decision.sourceID),
+			ChannelID:  stringValueOrNull(decision.channelID),
+		})
+	}
+
+	data.Permissions = types.Int64Value(permissions)
+
+	namesValue, diags := types.ListValueFrom(ctx, types.StringType, permissionNames(permissions))
+	resp.Diagnostics.Append(diags...)
+	explanationsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: permissionExplanationAttributeTypes}, explanations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.PermissionNames = namesValue
+	data.Explanations = explanationsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// computeEffectivePermissions applies Discord's permission algorithm and returns the effective permissions in
+// the channel with, for every flag that a role grants or an overwrite or implicit rule touches, what decided it.
+func computeEffectivePermissions(pc permissionContext) (int64, map[int64]permissionDecision) {
+	decisions := make(map[int64]permissionDecision)
+
+	// The guild owner has every permission
+	if pc.userID != "" && pc.userID == pc.ownerID {
+		for _, flag := range permissionFlags {
+			decisions[flag.bit] = permissionDecision{allowed: true, source: permissionSourceOwner, sourceID: pc.userID}
+		}
+		return allPermissions, decisions
+	}
+
+	// Base permissions: @everyone, then the union of the roles. The highest role granting a flag is credited.
+	permissions := pc.everyone.Permissions
+	for _, role := range pc.roles {
+		permissions |= role.Permissions
+	}
+	for _, flag := range permissionFlags {
+		if permissions&flag.bit == 0 {
+			continue
+		}
+		sourceID := pc.everyone.ID
+		for _, role := range pc.roles {
+			if role.Permissions&flag.bit != 0 {
+				sourceID = role.ID
+				break
+			}
+		}
+		decisions[flag.bit] = permissionDecision{allowed: true, source: permissionSourceRole, sourceID: sourceID}
+	}
+
+	// Administrators have every permission, and overwrites do not apply to them
+	if permissions&discordgo.PermissionAdministrator != 0 {
+		adminRoleID := decisions[discordgo.PermissionAdministrator].sourceID
+		for _, flag := range permissionFlags {
+			decisions[flag.bit] = permissionDecision{allowed: true, source: permissionSourceAdministrator, sourceID: adminRoleID}
+		}
+		return allPermissions, decisions
+	}
+
+	// Overwrites of the category, then of the channel. An overwrite the channel has from its category is
+	// credited to the category, so it is not applied again.
+	applyOverwrites := func(overwrites []*discordgo.PermissionOverwrite, channelID string) {
+		apply := func(allow, deny int64, source string, allowedBy, deniedBy func(bit int64) string) {
+			for _, flag := range permissionFlags {
+				switch {
+				case allow&flag.bit != 0:
+					permissions |= flag.bit
+					decisions[flag.bit] = permissionDecision{allowed: true, source: source, sourceID: allowedBy(flag.bit), channelID: channelID}
+				case deny&flag.bit != 0:
+					permissions &^= flag.bit
+					decisions[flag.bit] = permissionDecision{allowed: false, source: source, sourceID: deniedBy(flag.bit), channelID: channelID}
+				}
+			}
+		}
+
+		// @everyone overwrite
+		for _, ow := range overwrites {
+			if ow.Type == discordgo.PermissionOverwriteTypeRole && ow.ID == pc.guildID {
+				id := func(int64) string { return ow.ID }
+				apply(ow.Allow, ow.Deny, permissionSourceEveryoneOverwrite, id, id)
+			}
+		}
+
+		// Role overwrites: every denial is applied before every allowance, so an allowance on any role wins. The
+		// highest role is credited.
+		var roleOverwrites []*discordgo.PermissionOverwrite
+		for _, role := range pc.roles {
+			for _, ow := range overwrites {
+				if ow.Type == discordgo.PermissionOverwriteTypeRole && ow.ID == role.ID {
+					roleOverwrites = append(roleOverwrites, ow)
+				}
+			}
+		}
+		var allow, deny int64
+		for _, ow := range roleOverwrites {
+			allow |= ow.Allow
+			deny |= ow.Deny
+		}
+		firstWith := func(bits func(*discordgo.PermissionOverwrite) int64) func(int64) string {
+			return func(bit int64) string {
+				for _, ow := range roleOverwrites {
+					if bits(ow)&bit != 0 {
+						return ow.ID
+					}
+				}
+				return ""
+			}
+		}
+		apply(allow, deny, permissionSourceRoleOverwrite,
+			firstWith(func(ow *discordgo.PermissionOverwrite) int64 { return ow.Allow }),
+			firstWith(func(ow *discordgo.PermissionOverwrite) int64 { return ow.Deny }))
+
+		// Member overwrite
+		if pc.userID != "" {
+			for _, ow := range overwrites {
+				if ow.Type == discordgo.PermissionOverwriteTypeMember && ow.ID == pc.userID {
+					id := func(int64) string { return ow.ID }
+					apply(ow.Allow, ow.Deny, permissionSourceMemberOverwrite, id, id)
+				}
+			}
+		}
+	}
+	applyOverwrites(pc.categoryOverwrites, pc.categoryID)
+	var channelOverwrites []*discordgo.PermissionOverwrite
+	for _, ow := range pc.overwrites {
+		if !containsOverwrite(pc.categoryOverwrites, ow) {
+			channelOverwrites = append(channelOverwrites, ow)
+		}
+	}
+	applyOverwrites(channelOverwrites, pc.overwritesChannelID)
+
+	// Implicit denials: without VIEW_CHANNEL nothing applies in the channel, and without SEND_MESSAGES the
+	// permissions that only affect sending messages do not. The overwrite behind the denial is credited.
+	denyImplicitly := func(bits int64, source string, cause permissionDecision) {
+		for _, flag := range permissionFlags {
+			if bits&flag.bit != 0 {
+				permissions &^= flag.bit
+				decisions[flag.bit] = permissionDecision{allowed: false, source: source, sourceID: cause.sourceID, channelID: cause.channelID}
+			}
+		}
+	}
+	if permissions&discordgo.PermissionViewChannel == 0 {
+		denyImplicitly(permissions, permissionSourceImplicitViewChannel, decisions[discordgo.PermissionViewChannel])
+	} else if permissions&discordgo.PermissionSendMessages == 0 {
+		denyImplicitly(permissions&sendMessagesPermissions, permissionSourceImplicitSendMessages, decisions[discordgo.PermissionSendMessages])
+	}
+
+	return permissions, decisions
+}
+
+// containsOverwrite reports whether overwrites has an overwrite with the same target, allowances and denials as ow.
+func containsOverwrite(overwrites []*discordgo.PermissionOverwrite, ow *discordgo.PermissionOverwrite) bool {
+	for _, other := range overwrites {
+		if other.ID == ow.ID && other.Type == ow.Type && other.Allow == ow.Allow && other.Deny == ow.Deny {
+			return true
+		}
+	}
+	return false
+}
+
+// overwritesEqual reports whether two channels have the same permission overwrites, in any order, as Discord
+// requires for a channel to be synced with its category.
+func overwritesEqual(a, b []*discordgo.PermissionOverwrite) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	byTarget := make(map[string]*discordgo.PermissionOverwrite, len(a))
+	for _, ow := range a {
+		byTarget[ow.ID] = ow
+	}
+	for _, ow := range b {
+		other, ok := byTarget[ow.ID]
+		if !ok || other.Type != ow.Type || other.Allow != ow.Allow || other.Deny != ow.Deny {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,464 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectivePermissionsDataSource_Metadata(t *testing.T) {
+	d := NewEffectivePermissionsDataSource()
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	d.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_effective_permissions", resp.TypeName)
+}
+
+func TestEffectivePermissionsDataSource_Schema(t *testing.T) {
+	d := NewEffectivePermissionsDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	d.Schema(t.Context(), req, resp)
+
+	assert.NotNil(t, resp.Schema)
+	assert.Contains(t, resp.Schema.Description, "effective permissions")
+
+	channelAttr, ok := resp.Schema.Attributes["channel_id"]
+	assert.True(t, ok)
+	assert.True(t, channelAttr.IsRequired())
+
+	for _, attrName := range []string{"guild_id", "user_id", "role_ids"} {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsOptional(), "Attribute %s should be optional", attrName)
+	}
+
+	for _, attrName := range []string{"permissions", "permission_names", "explanations"} {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsComputed(), "Attribute %s should be computed", attrName)
+	}
+}
+
+func TestEffectivePermissionsDataSource_Configure(t *testing.T) {
+	tests := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
+			expectError:  false,
+		},
+		{
+			name:          "invalid provider data type",
+			providerData:  "invalid",
+			expectError:   true,
+			errorContains: "Unexpected Data Source Configure Type",
+		},
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &effectivePermissionsDataSource{}
+			req := datasource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			d.Configure(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+func TestComputeEffectivePermissions(t *testing.T) {
+	const (
+		guildID  = "100"
+		modsID   = "200"
+		mutedID  = "300"
+		userID   = "400"
+		ownerID  = "500"
+		view     = discordgo.PermissionViewChannel
+		send     = discordgo.PermissionSendMessages
+		kick     = discordgo.PermissionKickMembers
+		channel  = "600"
+		category = "700"
+	)
+	everyone := &discordgo.Role{ID: guildID, Permissions: view | send}
+	mods := &discordgo.Role{ID: modsID, Position: 2, Permissions: kick}
+	muted := &discordgo.Role{ID: mutedID, Position: 1}
+
+	t.Run("base permissions credit the highest role", func(t *testing.T) {
+		permissions, decisions := computeEffectivePermissions(permissionContext{
+			guildID:  guildID,
+			everyone: everyone,
+			roles:    []*discordgo.Role{mods, muted},
+		})
+		assert.Equal(t, int64(view|send|kick), permissions)
+		assert.Equal(t, permissionDecision{allowed: true, source: permissionSourceRole, sourceID: modsID}, decisions[kick])
+		assert.Equal(t, permissionDecision{allowed: true, source: permissionSourceRole, sourceID: guildID}, decisions[view])
+	})
+
+	t.Run("owner has every permission", func(t *testing.T) {
+		permissions, decisions := computeEffectivePermissions(permissionContext{
+			guildID:    guildID,
+			ownerID:    ownerID,
+			userID:     ownerID,
+			everyone:   everyone,
+			overwrites: []*discordgo.PermissionOverwrite{{ID: guildID, Type: discordgo.PermissionOverwriteTypeRole, Deny: view}},
+		})
+		assert.Equal(t, allPermissions, permissions)
+		assert.Equal(t, permissionSourceOwner, decisions[view].source)
+	})
+
+	t.Run("administrator ignores overwrites", func(t *testing.T) {
+		admin := &discordgo.Role{ID: "800", Position: 3, Permissions: discordgo.PermissionAdministrator}
+		permissions, decisions := computeEffectivePermissions(permissionContext{
+			guildID:    guildID,
+			everyone:   everyone,
+			roles:      []*discordgo.Role{admin},
+			overwrites: []*discordgo.PermissionOverwrite{{ID: guildID, Type: discordgo.PermissionOverwriteTypeRole, Deny: view}},
+		})
+		assert.Equal(t, allPermissions, permissions)
+		assert.Equal(t, permissionDecision{allowed: true, source: permissionSourceAdministrator, sourceID: admin.ID}, decisions[view])
+	})
+
+	t.Run("overwrites apply @everyone, then roles, then the member", func(t *testing.T) {
+		permissions, decisions := computeEffectivePermissions(permissionContext{
+			guildID:  guildID,
+			userID:   userID,
+			everyone: everyone,
+			roles:    []*discordgo.Role{mods, muted},
+			overwrites: []*discordgo.PermissionOverwrite{
+				{ID: guildID, Type: discordgo.PermissionOverwriteTypeRole, Deny: view | send},
+				{ID: mutedID, Type: discordgo.PermissionOverwriteTypeRole, Deny: view},
+				{ID: modsID, Type: discordgo.PermissionOverwriteTypeRole, Allow: view},
+				{ID: userID, Type: discordgo.PermissionOverwriteTypeMember, Deny: kick},
+			},
+			overwritesChannelID: channel,
+		})
+		assert.Equal(t, int64(view), permissions)
+		assert.Equal(t, permissionDecision{allowed: true, source: permissionSourceRoleOverwrite, sourceID: modsID, channelID: channel}, decisions[view],
+			"an allowance on any role wins over a denial on another")
+		assert.Equal(t, permissionDecision{allowed: false, source: permissionSourceEveryoneOverwrite, sourceID: guildID, channelID: channel}, decisions[send])
+		assert.Equal(t, permissionDecision{allowed: false, source: permissionSourceMemberOverwrite, sourceID: userID, channelID: channel}, decisions[kick])
+	})
+
+	t.Run("member overwrites only apply to members", func(t *testing.T) {
+		permissions, _ := computeEffectivePermissions(permissionContext{
+			guildID:             guildID,
+			everyone:            everyone,
+			overwrites:          []*discordgo.PermissionOverwrite{{ID: userID, Type: discordgo.PermissionOverwriteTypeMember, Deny: view}},
+			overwritesChannelID: category,
+		})
+		assert.Equal(t, int64(view|send), permissions)
+	})
+
+	t.Run("synced category overwrites are credited to the category", func(t *testing.T) {
+		overwrites := []*discordgo.PermissionOverwrite{
+			{ID: guildID, Type: discordgo.PermissionOverwriteTypeRole, Deny: send},
+			{ID: modsID, Type: discordgo.PermissionOverwriteTypeRole, Allow: send},
+		}
+		permissions, decisions := computeEffectivePermissions(permissionContext{
+			guildID:             guildID,
+			everyone:            everyone,
+			roles:               []*discordgo.Role{mods},
+			categoryOverwrites:  overwrites,
+			categoryID:          category,
+			overwrites:          overwrites,
+			overwritesChannelID: channel,
+		})
+		assert.Equal(t, int64(view|send|kick), permissions)
+		assert.Equal(t, permissionDecision{allowed: true, source: permissionSourceRoleOverwrite, sourceID: modsID, channelID: category}, decisions[send])
+	})
+
+	t.Run("channel overwrites apply after the category's", func(t *testing.T) {
+		permissions, decisions := computeEffectivePermissions(permissionContext{
+			guildID:             guildID,
+			userID:              userID,
+			everyone:            everyone,
+			categoryOverwrites:  []*discordgo.PermissionOverwrite{{ID: userID, Type: discordgo.PermissionOverwriteTypeMember, Deny: send}},
+			categoryID:          category,
+			overwrites:          []*discordgo.PermissionOverwrite{{ID: guildID, Type: discordgo.PermissionOverwriteTypeRole, Allow: send}},
+			overwritesChannelID: channel,
+		})
+		assert.Equal(t, int64(view|send), permissions)
+		assert.Equal(t, permissionDecision{allowed: true, source: permissionSourceEveryoneOverwrite, sourceID: guildID, channelID: channel}, decisions[send])
+	})
+}
+
+func TestComputeEffectivePermissions_ImplicitDenials(t *testing.T) {
+	const (
+		guildID = "100"
+		mutedID = "300"
+		userID  = "400"
+		ownerID = "500"
+		channel = "600"
+		view    = discordgo.PermissionViewChannel
+		send    = discordgo.PermissionSendMessages
+		attach  = discordgo.PermissionAttachFiles
+		embed   = discordgo.PermissionEmbedLinks
+		mention = discordgo.PermissionMentionEveryone
+		tts     = discordgo.PermissionSendTTSMessages
+		kick    = discordgo.PermissionKickMembers
+	)
+	everyone := &discordgo.Role{ID: guildID, Permissions: view | send | attach | embed | mention | tts}
+	muted := &discordgo.Role{ID: mutedID, Position: 1, Permissions: kick}
+	admin := &discordgo.Role{ID: "800", Position: 2, Permissions: discordgo.PermissionAdministrator}
+
+	tests := []struct {
+		name       string
+		pc         permissionContext
+		want       int64
+		wantDenied map[int64]permissionDecision
+	}{
+		{
+			name: "no VIEW_CHANNEL clears every permission",
+			pc: permissionContext{
+				userID:     userID,
+				everyone:   everyone,
+				roles:      []*discordgo.Role{muted},
+				overwrites: []*discordgo.PermissionOverwrite{{ID: guildID, Type: discordgo.PermissionOverwriteTypeRole, Deny: view}},
+			},
+			want: 0,
+			wantDenied: map[int64]permissionDecision{
+				view: {allowed: false, source: permissionSourceEveryoneOverwrite, sourceID: guildID, channelID: channel},
+				send: {allowed: false, source: permissionSourceImplicitViewChannel, sourceID: guildID, channelID: channel},
+				kick: {allowed: false, source: permissionSourceImplicitViewChannel, sourceID: guildID, channelID: channel},
+			},
+		},
+		{
+			name: "VIEW_CHANNEL never granted clears every permission",
+			pc: permissionContext{
+				everyone: &discordgo.Role{ID: guildID, Permissions: send},
+				roles:    []*discordgo.Role{muted},
+			},
+			want: 0,
+			wantDenied: map[int64]permissionDecision{
+				send: {allowed: false, source: permissionSourceImplicitViewChannel},
+				kick: {allowed: false, source: permissionSourceImplicitViewChannel},
+			},
+		},
+		{
+			name: "a member overwrite restoring VIEW_CHANNEL keeps the permissions",
+			pc: permissionContext{
+				userID:   userID,
+				everyone: everyone,
+				overwrites: []*discordgo.PermissionOverwrite{
+					{ID: guildID, Type: discordgo.PermissionOverwriteTypeRole, Deny: view},
+					{ID: userID, Type: discordgo.PermissionOverwriteTypeMember, Allow: view},
+				},
+			},
+			want: view | send | attach | embed | mention | tts,
+		},
+		{
+			name: "no SEND_MESSAGES clears the message permissions",
+			pc: permissionContext{
+				userID:     userID,
+				everyone:   everyone,
+				roles:      []*discordgo.Role{muted},
+				overwrites: []*discordgo.PermissionOverwrite{{ID: mutedID, Type: discordgo.PermissionOverwriteTypeRole, Deny: send}},
+			},
+			want: view | kick,
+			wantDenied: map[int64]permissionDecision{
+				send:    {allowed: false, source: permissionSourceRoleOverwrite, sourceID: mutedID, channelID: channel},
+				attach:  {allowed: false, source: permissionSourceImplicitSendMessages, sourceID: mutedID, channelID: channel},
+				embed:   {allowed: false, source: permissionSourceImplicitSendMessages, sourceID: mutedID, channelID: channel},
+				mention: {allowed: false, source: permissionSourceImplicitSendMessages, sourceID: mutedID, channelID: channel},
+				tts:     {allowed: false, source: permissionSourceImplicitSendMessages, sourceID: mutedID, channelID: channel},
+			},
+		},
+		{
+			name: "the owner is exempt",
+			pc: permissionContext{
+				ownerID:    ownerID,
+				userID:     ownerID,
+				everyone:   everyone,
+				overwrites: []*discordgo.PermissionOverwrite{{ID: guildID, Type: discordgo.PermissionOverwriteTypeRole, Deny: view | send}},
+			},
+			want: allPermissions,
+		},
+		{
+			name: "administrators are exempt",
+			pc: permissionContext{
+				userID:     userID,
+				everyone:   everyone,
+				roles:      []*discordgo.Role{admin},
+				overwrites: []*discordgo.PermissionOverwrite{{ID: guildID, Type: discordgo.PermissionOverwriteTypeRole, Deny: view | send}},
+			},
+			want: allPermissions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.pc.guildID = guildID
+			tt.pc.overwritesChannelID = channel
+
+			permissions, decisions := computeEffectivePermissions(tt.pc)
+
+			assert.Equal(t, tt.want, permissions)
+			for bit, want := range tt.wantDenied {
+				assert.Equal(t, want, decisions[bit], permissionNames(bit))
+			}
+		})
+	}
+}
+
+func TestOverwritesEqual(t *testing.T) {
+	a := &discordgo.PermissionOverwrite{ID: "1", Type: discordgo.PermissionOverwriteTypeRole, Allow: 1024}
+	b := &discordgo.PermissionOverwrite{ID: "2", Type: discordgo.PermissionOverwriteTypeMember, Deny: 2048}
+	changed := &discordgo.PermissionOverwrite{ID: "2", Type: discordgo.PermissionOverwriteTypeMember, Deny: 1024}
+
+	assert.True(t, overwritesEqual(nil, []*discordgo.PermissionOverwrite{}))
+	assert.True(t, overwritesEqual([]*discordgo.PermissionOverwrite{a, b}, []*discordgo.PermissionOverwrite{b, a}))
+	assert.False(t, overwritesEqual([]*discordgo.PermissionOverwrite{a, b}, []*discordgo.PermissionOverwrite{a, changed}))
+	assert.False(t, overwritesEqual([]*discordgo.PermissionOverwrite{a}, []*discordgo.PermissionOverwrite{a, b}))
+}
+
+func TestAccEffectivePermissionsDataSource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	moderators := s.AddRole(guild.ID, "Moderators")
+	s.EditRole(guild.ID, moderators.ID, func(role *discordgo.Role) {
+		role.Permissions = discordgo.PermissionKickMembers
+	})
+	alice := s.AddUser("alice")
+	s.AddMember(guild.ID, alice, moderators.ID)
+	bob := s.AddUser("bob")
+	s.AddMember(guild.ID, bob)
+
+	// #mod-log is synced with the Staff category, which only moderators can see
+	staffOverwrites := []*discordgo.PermissionOverwrite{
+		{ID: guild.ID, Type: discordgo.PermissionOverwriteTypeRole, Deny: discordgo.PermissionViewChannel},
+		{ID: moderators.ID, Type: discordgo.PermissionOverwriteTypeRole, Allow: discordgo.PermissionViewChannel},
+	}
+	staff := s.AddChannel(guild.ID, "Staff", discordgo.ChannelTypeGuildCategory, "")
+	modLog := s.AddChannel(guild.ID, "mod-log", discordgo.ChannelTypeGuildText, staff.ID)
+	s.EditChannel(staff.ID, func(ch *discordgo.Channel) { ch.PermissionOverwrites = staffOverwrites })
+	s.EditChannel(modLog.ID, func(ch *discordgo.Channel) { ch.PermissionOverwrites = staffOverwrites })
+
+	// #appeals is in the same category but no longer synced: bob may see it
+	appeals := s.AddChannel(guild.ID, "appeals", discordgo.ChannelTypeGuildText, staff.ID)
+	s.EditChannel(appeals.ID, func(ch *discordgo.Channel) {
+		ch.PermissionOverwrites = append([]*discordgo.PermissionOverwrite{
+			{ID: bob.ID, Type: discordgo.PermissionOverwriteTypeMember, Allow: discordgo.PermissionViewChannel},
+		}, staffOverwrites...)
+	})
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "discord_effective_permissions" "alice" {
+  channel_id = %[1]q
+  user_id    = %[2]q
+}
+
+data "discord_effective_permissions" "bob" {
+  channel_id = %[1]q
+  user_id    = %[3]q
+}
+
+data "discord_effective_permissions" "moderators" {
+  guild_id   = %[4]q
+  channel_id = %[1]q
+  role_ids   = [%[5]q]
+}
+
+data "discord_effective_permissions" "bob_appeals" {
+  channel_id = %[6]q
+  user_id    = %[3]q
+}
+
+data "discord_effective_permissions" "owner" {
+  channel_id = %[1]q
+  user_id    = %[7]q
+}
+`, modLog.ID, alice.ID, bob.ID, guild.ID, moderators.ID, appeals.ID, guild.OwnerID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_effective_permissions.alice", "guild_id", guild.ID),
+					tfresource.TestCheckTypeSetElemAttr("data.discord_effective_permissions.alice", "permission_names.*", "VIEW_CHANNEL"),
+					tfresource.TestCheckTypeSetElemAttr("data.discord_effective_permissions.alice", "permission_names.*", "KICK_MEMBERS"),
+					tfresource.TestCheckTypeSetElemNestedAttrs("data.discord_effective_permissions.alice", "explanations.*", map[string]string{
+						"permission": "VIEW_CHANNEL",
+						"allowed":    "true",
+						"source":     "role_overwrite",
+						"source_id":  moderators.ID,
+						"channel_id": staff.ID,
+					}),
+					tfresource.TestCheckTypeSetElemNestedAttrs("data.discord_effective_permissions.bob", "explanations.*", map[string]string{
+						"permission": "VIEW_CHANNEL",
+						"allowed":    "false",
+						"source":     "everyone_overwrite",
+						"source_id":  guild.ID,
+					}),
+					// Without VIEW_CHANNEL, bob has no permissions in the channel at all
+					tfresource.TestCheckResourceAttr("data.discord_effective_permissions.bob", "permissions", "0"),
+					tfresource.TestCheckResourceAttr("data.discord_effective_permissions.bob", "permission_names.#", "0"),
+					tfresource.TestCheckTypeSetElemNestedAttrs("data.discord_effective_permissions.bob", "explanations.*", map[string]string{
+						"permission": "SEND_MESSAGES",
+						"allowed":    "false",
+						"source":     "implicit_view_channel",
+						"source_id":  guild.ID,
+						"channel_id": staff.ID,
+					}),
+					tfresource.TestCheckResourceAttrPair("data.discord_effective_permissions.moderators", "permissions", "data.discord_effective_permissions.alice", "permissions"),
+					tfresource.TestCheckTypeSetElemNestedAttrs("data.discord_effective_permissions.bob_appeals", "explanations.*", map[string]string{
+						"permission": "VIEW_CHANNEL",
+						"allowed":    "true",
+						"source":     "member_overwrite",
+						"source_id":  bob.ID,
+						"channel_id": appeals.ID,
+					}),
+					tfresource.TestCheckResourceAttr("data.discord_effective_permissions.owner", "permission_names.#", fmt.Sprint(len(permissionFlags))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEffectivePermissionsDataSource_invalidConfig(t *testing.T) {
+	_, providerConfig := testAccFakeDiscord(t)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + `
+data "discord_effective_permissions" "test" {
+  channel_id = "123456789012345678"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing Attribute Configuration`),
+			},
+		},
+	})
+}
@@ -17,6 +17,8 @@ var _ datasource.DataSource = &emojiDataSource{}
 // emojiDataSource defines the data source implementation.
 type emojiDataSource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // emojiDataSourceModel describes the data source data model.
@@ -57,8 +59,9 @@ func (d *emojiDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Optional:    true,
 			},
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the guild (server) where the emoji is located. Must be provided along with name if emoji_id is not specified.",
+				Description: "The ID of the guild (server) where the emoji is located. Must be provided along with name if emoji_id is not specified. Defaults to the provider's guild_id.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"id": schema.StringAttribute{
 				Description: "The ID of the emoji.",
@@ -99,16 +102,17 @@ func (d *emojiDataSource) Configure(_ context.Context, req datasource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -132,13 +136,14 @@ func (d *emojiDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	emojiID := data.EmojiID.ValueString()
 	name := data.Name.ValueString()
+	data.GuildID = guildIDOrDefault(data.GuildID, d.defaultGuildID)
 	guildID := data.GuildID.ValueString()
 
 	// Validate that either emoji_id or (name + guild_id) is provided
 	if emojiID == "" && (name == "" || guildID == "") {
 		resp.Diagnostics.AddError(
 			"Missing Required Attributes",
-			"Either emoji_id or both name and guild_id must be provided.",
+			"Either emoji_id or both name and guild_id must be provided. guild_id defaults to the provider's guild_id.",
 		)
 		return
 	}
@@ -155,22 +160,22 @@ func (d *emojiDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			return
 		}
 
-		fetchedEmoji, err := d.client.GuildEmoji(guildID, emojiID)
+		fetchedEmoji, err := d.client.GuildEmoji(guildID, emojiID, discordgo.WithContext(ctx))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Fetching Emoji",
-				fmt.Sprintf("Unable to fetch emoji %s from guild %s: %s", emojiID, guildID, err.Error()),
+				discordErrorDetail(fmt.Sprintf("Unable to fetch emoji %s from guild %s", emojiID, guildID), err),
 			)
 			return
 		}
 		emoji = fetchedEmoji
 	} else {
 		// Look up by name - fetch all emojis and find matching name
-		emojis, err := d.client.GuildEmojis(guildID)
+		emojis, err := d.client.GuildEmojis(guildID, discordgo.WithContext(ctx))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Fetching Emojis",
-				fmt.Sprintf("Unable to fetch emojis for guild %s: %s", guildID, err.Error()),
+				discordErrorDetail(fmt.Sprintf("Unable to fetch emojis for guild %s", guildID), err),
 			)
 			return
 		}
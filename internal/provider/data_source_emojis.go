@@ -17,6 +17,8 @@ var _ datasource.DataSource = &emojisDataSource{}
 // emojisDataSource defines the data source implementation.
 type emojisDataSource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // emojisDataSourceModel describes the data source data model.
@@ -53,8 +55,9 @@ func (d *emojisDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 		Description: "Retrieves all custom emojis from a Discord guild (server).",
 		Attributes: map[string]schema.Attribute{
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the Discord guild (server).",
-				Required:    true,
+				Description: "The ID of the Discord guild (server). Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"emojis": schema.ListNestedAttribute{
 				Description: "List of custom emojis in the guild.",
@@ -107,16 +110,17 @@ func (d *emojisDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -138,21 +142,22 @@ func (d *emojisDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	data.GuildID = guildIDOrDefault(data.GuildID, d.defaultGuildID)
 	guildID := data.GuildID.ValueString()
 	if guildID == "" {
 		resp.Diagnostics.AddError(
 			"Missing Guild ID",
-			"The guild_id attribute is required.",
+			missingGuildIDDetail,
 		)
 		return
 	}
 
 	// Fetch all emojis for the guild
-	emojis, err := d.client.GuildEmojis(guildID)
+	emojis, err := d.client.GuildEmojis(guildID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Emojis",
-			fmt.Sprintf("Unable to fetch emojis for guild %s: %s", guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch emojis for guild %s", guildID), err),
 		)
 		return
 	}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source type implements the required interfaces.
+var _ datasource.DataSource = &inviteDataSource{}
+
+// inviteDataSource defines the data source implementation.
+type inviteDataSource struct {
+	client *discordgo.Session
+}
+
+// inviteDataSourceModel describes the data source data model.
+type inviteDataSourceModel struct {
+	Code                     types.String `tfsdk:"code"`
+	ID                       types.String `tfsdk:"id"`
+	URL                      types.String `tfsdk:"url"`
+	GuildID                  types.String `tfsdk:"guild_id"`
+	GuildName                types.String `tfsdk:"guild_name"`
+	GuildIcon                types.String `tfsdk:"guild_icon"`
+	GuildDescription         types.String `tfsdk:"guild_description"`
+	GuildFeatures            types.List   `tfsdk:"guild_features"`
+	GuildVerificationLevel   types.Int64  `tfsdk:"guild_verification_level"`
+	ChannelID                types.String `tfsdk:"channel_id"`
+	ChannelName              types.String `tfsdk:"channel_name"`
+	ChannelType              types.Int64  `tfsdk:"channel_type"`
+	InviterID                types.String `tfsdk:"inviter_id"`
+	ExpiresAt                types.String `tfsdk:"expires_at"`
+	ApproximateMemberCount   types.Int64  `tfsdk:"approximate_member_count"`
+	ApproximatePresenceCount types.Int64  `tfsdk:"approximate_presence_count"`
+}
+
+// NewInviteDataSource is a helper function to simplify testing.
+func NewInviteDataSource() datasource.DataSource {
+	return &inviteDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *inviteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invite"
+}
+
+// Schema defines the schema for the data source.
+func (d *inviteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a Discord invite by code, with a preview of its guild and channel. Works for invites to any guild, including ones the bot is not a member of, so it can validate partner-server invites.",
+		Attributes: map[string]schema.Attribute{
+			"code": schema.StringAttribute{
+				Description: "The invite code, the part after https://discord.gg/.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the invite (same as code).",
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The full invite URL (https://discord.gg/{code}).",
+				Computed:    true,
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild (server) the invite is for.",
+				Computed:    true,
+			},
+			"guild_name": schema.StringAttribute{
+				Description: "The name of the guild.",
+				Computed:    true,
+			},
+			"guild_icon": schema.StringAttribute{
+				Description: "The icon hash of the guild, or null if it has none.",
+				Computed:    true,
+			},
+			"guild_description": schema.StringAttribute{
+				Description: "The description of the guild, or null if it has none.",
+				Computed:    true,
+			},
+			"guild_features": schema.ListAttribute{
+				Description: "The features enabled for the guild.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"guild_verification_level": schema.Int64Attribute{
+				Description: "The verification level of the guild (0 = None, 1 = Low, 2 = Medium, 3 = High, 4 = Very High).",
+				Computed:    true,
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the channel the invite is for.",
+				Computed:    true,
+			},
+			"channel_name": schema.StringAttribute{
+				Description: "The name of the channel.",
+				Computed:    true,
+			},
+			"channel_type": schema.Int64Attribute{
+				Description: "The type of the channel. 0 = text channel, 2 = voice channel, etc.",
+				Computed:    true,
+			},
+			"inviter_id": schema.StringAttribute{
+				Description: "The ID of the user who created the invite, or null if unknown.",
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "When the invite expires (ISO 8601 timestamp), or null if it never expires.",
+				Computed:    true,
+			},
+			"approximate_member_count": schema.Int64Attribute{
+				Description: "The approximate number of members in the guild.",
+				Computed:    true,
+			},
+			"approximate_presence_count": schema.Int64Attribute{
+				Description: "The approximate number of online members in the guild.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure sets up the data source with the provider's configured client.
+func (d *inviteDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *inviteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data inviteDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure client is configured
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	code := data.Code.ValueString()
+	invite, err := d.client.InviteComplex(code, "", true, true, discordgo.WithContext(ctx))
+	if err != nil {
+		if isDiscordNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Invite Not Found",
+				fmt.Sprintf("Invite %s was not found. It may have been deleted, expired or used up.", code),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Fetching Invite",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch invite %s", code), err),
+		)
+		return
+	}
+
+	// Populate the model with invite data
+	data.ID = types.StringValue(invite.Code)
+	data.URL = types.StringValue("https://discord.gg/" + invite.Code)
+	data.ApproximateMemberCount = types.Int64Value(int64(invite.ApproximateMemberCount))
+	data.ApproximatePresenceCount = types.Int64Value(int64(invite.ApproximatePresenceCount))
+
+	data.GuildID = types.StringNull()
+	data.GuildName = types.StringNull()
+	data.GuildIcon = types.StringNull()
+	data.GuildDescription = types.StringNull()
+	data.GuildVerificationLevel = types.Int64Null()
+	features := []string{}
+	if invite.Guild != nil {
+		data.GuildID = types.StringValue(invite.Guild.ID)
+		data.GuildName = types.StringValue(invite.Guild.Name)
+		data.GuildIcon = stringValueOrNull(invite.Guild.Icon)
+		data.GuildDescription = stringValueOrNull(invite.Guild.Description)
+		data.GuildVerificationLevel = types.Int64Value(int64(invite.Guild.VerificationLevel))
+		for _, feature := range invite.Guild.Features {
+			features = append(features, string(feature))
+		}
+	}
+	featuresValue, diags := types.ListValueFrom(ctx, types.StringType, features)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.GuildFeatures = featuresValue
+
+	data.ChannelID = types.StringNull()
+	data.ChannelName = types.StringNull()
+	data.ChannelType = types.Int64Null()
+	if invite.Channel != nil {
+		data.ChannelID = types.StringValue(invite.Channel.ID)
+		data.ChannelName = types.StringValue(invite.Channel.Name)
+		data.ChannelType = types.Int64Value(int64(invite.Channel.Type))
+	}
+
+	data.InviterID = types.StringNull()
+	if invite.Inviter != nil {
+		data.InviterID = types.StringValue(invite.Inviter.ID)
+	}
+
+	data.ExpiresAt = types.StringNull()
+	if expiresAt := inviteExpiresAt(invite); expiresAt != nil {
+		data.ExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInviteDataSource_Metadata(t *testing.T) {
+	d := NewInviteDataSource()
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	d.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_invite", resp.TypeName)
+}
+
+func TestInviteDataSource_Schema(t *testing.T) {
+	d := NewInviteDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	d.Schema(t.Context(), req, resp)
+
+	assert.NotNil(t, resp.Schema)
+	assert.Contains(t, resp.Schema.Description, "invite")
+
+	codeAttr, ok := resp.Schema.Attributes["code"]
+	assert.True(t, ok)
+	assert.True(t, codeAttr.IsRequired())
+
+	computedAttrs := []string{"guild_id", "guild_name", "guild_features", "channel_id", "channel_name", "approximate_member_count", "approximate_presence_count"}
+	for _, attrName := range computedAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsComputed(), "Attribute %s should be computed", attrName)
+	}
+}
+
+func TestInviteDataSource_Configure(t *testing.T) {
+	tests := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
+			expectError:  false,
+		},
+		{
+			name:          "invalid provider data type",
+			providerData:  "invalid",
+			expectError:   true,
+			errorContains: "Unexpected Data Source Configure Type",
+		},
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &inviteDataSource{}
+			req := datasource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			d.Configure(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+func TestAccInviteDataSource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+
+	// A partner guild the invite points to
+	partner := s.AddGuild("Partner Guild")
+	s.EditGuild(partner.ID, func(g *discordgo.Guild) {
+		g.Description = "Our partners"
+		g.VerificationLevel = discordgo.VerificationLevelMedium
+		g.Features = []discordgo.GuildFeature{discordgo.GuildFeatureCommunity}
+	})
+	lobby := s.AddChannel(partner.ID, "lobby", discordgo.ChannelTypeGuildText, "")
+	s.AddMember(partner.ID, s.AddUser("alice"))
+	s.AddInvite(lobby.ID, discordgo.Invite{Code: "partners"})
+	s.AddInvite(lobby.ID, discordgo.Invite{Code: "used-up", MaxUses: 1, Uses: 1})
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + `
+data "discord_invite" "test" {
+  code = "partners"
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "id", "partners"),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "url", "https://discord.gg/partners"),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "guild_id", partner.ID),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "guild_name", "Partner Guild"),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "guild_description", "Our partners"),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "guild_verification_level", "2"),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "guild_features.#", "1"),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "guild_features.0", "COMMUNITY"),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "channel_id", lobby.ID),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "channel_name", "lobby"),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "channel_type", "0"),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "inviter_id", s.BotUser.ID),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "approximate_member_count", "3"),
+					tfresource.TestCheckResourceAttr("data.discord_invite.test", "approximate_presence_count", "1"),
+					tfresource.TestCheckNoResourceAttr("data.discord_invite.test", "expires_at"),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "discord_invite" "test" {
+  code = "used-up"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invite Not Found`),
+			},
+		},
+	})
+}
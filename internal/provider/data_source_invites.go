@@ -0,0 +1,354 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source type implements the required interfaces.
+var _ datasource.DataSource = &invitesDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &invitesDataSource{}
+
+// invitesDataSource defines the data source implementation.
+type invitesDataSource struct {
+	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when neither channel_id nor guild_id is set.
+	defaultGuildID types.String
+}
+
+// invitesDataSourceModel describes the data source data model.
+type invitesDataSourceModel struct {
+	ChannelID types.String `tfsdk:"channel_id"`
+	GuildID   types.String `tfsdk:"guild_id"`
+	Expired   types.Bool   `tfsdk:"expired"`
+	Unlimited types.Bool   `tfsdk:"unlimited"`
+	Invites   types.List   `tfsdk:"invites"`
+}
+
+// invitesFilter holds the invite filters of the data source.
+type invitesFilter struct {
+	expired   *bool
+	unlimited *bool
+}
+
+// inviteModel describes a single invite in the data source.
+type inviteModel struct {
+	Code      types.String `tfsdk:"code"`
+	URL       types.String `tfsdk:"url"`
+	GuildID   types.String `tfsdk:"guild_id"`
+	ChannelID types.String `tfsdk:"channel_id"`
+	InviterID types.String `tfsdk:"inviter_id"`
+	Uses      types.Int64  `tfsdk:"uses"`
+	MaxUses   types.Int64  `tfsdk:"max_uses"`
+	MaxAge    types.Int64  `tfsdk:"max_age"`
+	Temporary types.Bool   `tfsdk:"temporary"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Expired   types.Bool   `tfsdk:"expired"`
+}
+
+// inviteAttributeTypes are the attribute types of an invites element.
+var inviteAttributeTypes = map[string]attr.Type{
+	"code":       types.StringType,
+	"url":        types.StringType,
+	"guild_id":   types.StringType,
+	"channel_id": types.StringType,
+	"inviter_id": types.StringType,
+	"uses":       types.Int64Type,
+	"max_uses":   types.Int64Type,
+	"max_age":    types.Int64Type,
+	"temporary":  types.BoolType,
+	"created_at": types.StringType,
+	"expires_at": types.StringType,
+	"expired":    types.BoolType,
+}
+
+// NewInvitesDataSource is a helper function to simplify testing.
+func NewInvitesDataSource() datasource.DataSource {
+	return &invitesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *invitesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invites"
+}
+
+// Schema defines the schema for the data source.
+func (d *invitesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the invites of a Discord channel, or of every channel in a guild, with their usage. Can be filtered to expired or unlimited invites.",
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the channel to list invites for. Conflicts with guild_id.",
+				Optional:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild (server) to list invites for. Conflicts with channel_id. Defaults to the provider's guild_id when channel_id is not set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"expired": schema.BoolAttribute{
+				Description: "When true, only invites that have passed their max age or used up their max uses are returned. When false, only invites that can still be used are returned.",
+				Optional:    true,
+			},
+			"unlimited": schema.BoolAttribute{
+				Description: "When true, only invites that never expire and have no use limit are returned. When false, only invites with a max age or max uses are returned.",
+				Optional:    true,
+			},
+			"invites": schema.ListNestedAttribute{
+				Description: "The matching invites.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Description: "The invite code.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "The full invite URL (https://discord.gg/{code}).",
+							Computed:    true,
+						},
+						"guild_id": schema.StringAttribute{
+							Description: "The ID of the guild (server) the invite is for.",
+							Computed:    true,
+						},
+						"channel_id": schema.StringAttribute{
+							Description: "The ID of the channel the invite is for.",
+							Computed:    true,
+						},
+						"inviter_id": schema.StringAttribute{
+							Description: "The ID of the user who created the invite, or null if unknown.",
+							Computed:    true,
+						},
+						"uses": schema.Int64Attribute{
+							Description: "How many times the invite has been used.",
+							Computed:    true,
+						},
+						"max_uses": schema.Int64Attribute{
+							Description: "The maximum number of uses. 0 means unlimited.",
+							Computed:    true,
+						},
+						"max_age": schema.Int64Attribute{
+							Description: "How long the invite is valid for, in seconds. 0 means it never expires.",
+							Computed:    true,
+						},
+						"temporary": schema.BoolAttribute{
+							Description: "Whether the invite grants temporary membership.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the invite was created (ISO 8601 timestamp).",
+							Computed:    true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "When the invite expires (ISO 8601 timestamp), or null if it never expires.",
+							Computed:    true,
+						},
+						"expired": schema.BoolAttribute{
+							Description: "Whether the invite has passed its max age or used up its max uses.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators prevents listing by channel and guild at the same time.
+func (d *invitesDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("channel_id"),
+			path.MatchRoot("guild_id"),
+		),
+	}
+}
+
+// Configure sets up the data source with the provider's configured client.
+func (d *invitesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *invitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data invitesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure client is configured
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	var invites []*discordgo.Invite
+	if channelID := data.ChannelID.ValueString(); channelID != "" {
+		var err error
+		invites, err = d.client.ChannelInvites(channelID, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Fetching Invites",
+				discordErrorDetail(fmt.Sprintf("Unable to fetch invites from channel %s", channelID), err),
+			)
+			return
+		}
+	} else {
+		data.GuildID = guildIDOrDefault(data.GuildID, d.defaultGuildID)
+		guildID := data.GuildID.ValueString()
+		if guildID == "" {
+			resp.Diagnostics.AddError(
+				"Missing Guild ID",
+				missingGuildIDDetail,
+			)
+			return
+		}
+
+		var err error
+		invites, err = d.client.GuildInvites(guildID, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Fetching Invites",
+				discordErrorDetail(fmt.Sprintf("Unable to fetch invites from guild %s", guildID), err),
+			)
+			return
+		}
+	}
+
+	var filter invitesFilter
+	if !data.Expired.IsNull() {
+		expired := data.Expired.ValueBool()
+		filter.expired = &expired
+	}
+	if !data.Unlimited.IsNull() {
+		unlimited := data.Unlimited.ValueBool()
+		filter.unlimited = &unlimited
+	}
+
+	now := time.Now()
+	models := make([]inviteModel, 0, len(invites))
+	for _, invite := range filterInvites(invites, filter, now) {
+		model := inviteModel{
+			Code:      types.StringValue(invite.Code),
+			URL:       types.StringValue("https://discord.gg/" + invite.Code),
+			GuildID:   types.StringNull(),
+			ChannelID: types.StringNull(),
+			InviterID: types.StringNull(),
+			Uses:      types.Int64Value(int64(invite.Uses)),
+			MaxUses:   types.Int64Value(int64(invite.MaxUses)),
+			MaxAge:    types.Int64Value(int64(invite.MaxAge)),
+			Temporary: types.BoolValue(invite.Temporary),
+			CreatedAt: types.StringNull(),
+			ExpiresAt: types.StringNull(),
+			Expired:   types.BoolValue(inviteExpired(invite, now)),
+		}
+		if invite.Guild != nil {
+			model.GuildID = types.StringValue(invite.Guild.ID)
+		}
+		if invite.Channel != nil {
+			model.ChannelID = types.StringValue(invite.Channel.ID)
+		}
+		if invite.Inviter != nil {
+			model.InviterID = types.StringValue(invite.Inviter.ID)
+		}
+		if !invite.CreatedAt.IsZero() {
+			model.CreatedAt = types.StringValue(invite.CreatedAt.UTC().Format(time.RFC3339))
+		}
+		if expiresAt := inviteExpiresAt(invite); expiresAt != nil {
+			model.ExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+		}
+		models = append(models, model)
+	}
+
+	invitesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: inviteAttributeTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Invites = invitesValue
+
+	// When listing by channel, report the channel's guild if any invite names it
+	if !data.ChannelID.IsNull() {
+		data.GuildID = types.StringNull()
+		if len(invites) > 0 && invites[0].Guild != nil {
+			data.GuildID = stringValueOrNull(invites[0].Guild.ID)
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// inviteExpiresAt returns when the invite expires, or nil if it never does. Discord only includes expires_at on
+// some endpoints, so it is otherwise derived from created_at and max_age.
+func inviteExpiresAt(invite *discordgo.Invite) *time.Time {
+	if invite.ExpiresAt != nil {
+		return invite.ExpiresAt
+	}
+	if invite.MaxAge <= 0 || invite.CreatedAt.IsZero() {
+		return nil
+	}
+	expiresAt := invite.CreatedAt.Add(time.Duration(invite.MaxAge) * time.Second)
+	return &expiresAt
+}
+
+// inviteExpired reports whether the invite had passed its max age or used up its max uses at now. Discord stops
+// resolving such invites but can still list them until they are cleaned up.
+func inviteExpired(invite *discordgo.Invite, now time.Time) bool {
+	if expiresAt := inviteExpiresAt(invite); expiresAt != nil && !now.Before(*expiresAt) {
+		return true
+	}
+	return invite.MaxUses > 0 && invite.Uses >= invite.MaxUses
+}
+
+// filterInvites returns the invites matching every filter that is set.
+func filterInvites(invites []*discordgo.Invite, filter invitesFilter, now time.Time) []*discordgo.Invite {
+	matches := make([]*discordgo.Invite, 0, len(invites))
+	for _, invite := range invites {
+		if filter.expired != nil && inviteExpired(invite, now) != *filter.expired {
+			continue
+		}
+		if filter.unlimited != nil && (invite.MaxAge == 0 && invite.MaxUses == 0) != *filter.unlimited {
+			continue
+		}
+		matches = append(matches, invite)
+	}
+	return matches
+}
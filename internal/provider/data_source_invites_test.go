@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvitesDataSource_Metadata(t *testing.T) {
+	d := NewInvitesDataSource()
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	d.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_invites", resp.TypeName)
+}
+
+func TestInvitesDataSource_Schema(t *testing.T) {
+	d := NewInvitesDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	d.Schema(t.Context(), req, resp)
+
+	assert.NotNil(t, resp.Schema)
+	assert.Contains(t, resp.Schema.Description, "invites")
+
+	for _, attrName := range []string{"channel_id", "guild_id", "expired", "unlimited"} {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsOptional(), "Attribute %s should be optional", attrName)
+	}
+
+	invitesAttr, ok := resp.Schema.Attributes["invites"]
+	assert.True(t, ok)
+	assert.True(t, invitesAttr.IsComputed())
+}
+
+func TestInvitesDataSource_Configure(t *testing.T) {
+	tests := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
+			expectError:  false,
+		},
+		{
+			name:          "invalid provider data type",
+			providerData:  "invalid",
+			expectError:   true,
+			errorContains: "Unexpected Data Source Configure Type",
+		},
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &invitesDataSource{}
+			req := datasource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			d.Configure(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+func TestInviteExpiresAt(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	expires := created.Add(time.Hour)
+
+	assert.Nil(t, inviteExpiresAt(&discordgo.Invite{CreatedAt: created}))
+	assert.Equal(t, &expires, inviteExpiresAt(&discordgo.Invite{CreatedAt: created, MaxAge: 3600}))
+	assert.Equal(t, &expires, inviteExpiresAt(&discordgo.Invite{ExpiresAt: &expires}))
+}
+
+func TestFilterInvites(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	unlimited := &discordgo.Invite{Code: "unlimited", CreatedAt: now.Add(-24 * time.Hour)}
+	active := &discordgo.Invite{Code: "active", CreatedAt: now.Add(-time.Hour), MaxAge: 86400}
+	aged := &discordgo.Invite{Code: "aged", CreatedAt: now.Add(-2 * time.Hour), MaxAge: 3600}
+	usedUp := &discordgo.Invite{Code: "used-up", CreatedAt: now, MaxUses: 5, Uses: 5}
+	invites := []*discordgo.Invite{unlimited, active, aged, usedUp}
+
+	yes, no := true, false
+	codes := func(invites []*discordgo.Invite) []string {
+		out := make([]string, 0, len(invites))
+		for _, invite := range invites {
+			out = append(out, invite.Code)
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"unlimited", "active", "aged", "used-up"}, codes(filterInvites(invites, invitesFilter{}, now)))
+	assert.Equal(t, []string{"aged", "used-up"}, codes(filterInvites(invites, invitesFilter{expired: &yes}, now)))
+	assert.Equal(t, []string{"unlimited", "active"}, codes(filterInvites(invites, invitesFilter{expired: &no}, now)))
+	assert.Equal(t, []string{"unlimited"}, codes(filterInvites(invites, invitesFilter{unlimited: &yes}, now)))
+	assert.Equal(t, []string{"active"}, codes(filterInvites(invites, invitesFilter{expired: &no, unlimited: &no}, now)))
+}
+
+func TestAccInvitesDataSource(t *testing.T) {
+	s, _ := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	general := s.AddChannel(guild.ID, "general", discordgo.ChannelTypeGuildText, "")
+	welcome := s.AddChannel(guild.ID, "welcome", discordgo.ChannelTypeGuildText, "")
+	moderator := s.AddUser("moderator")
+
+	s.AddInvite(general.ID, discordgo.Invite{Code: "forever", Inviter: moderator, Uses: 42})
+	s.AddInvite(general.ID, discordgo.Invite{Code: "used-up", MaxUses: 10, Uses: 10})
+	s.AddInvite(welcome.ID, discordgo.Invite{Code: "stale", MaxAge: 3600, CreatedAt: time.Now().Add(-2 * time.Hour)})
+	s.AddInvite(welcome.ID, discordgo.Invite{Code: "fresh", MaxAge: 86400, Temporary: true})
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfigWithGuild(s, guild.ID) + fmt.Sprintf(`
+data "discord_invites" "all" {}
+
+data "discord_invites" "general" {
+  channel_id = %[1]q
+}
+
+data "discord_invites" "expired" {
+  expired = true
+}
+
+data "discord_invites" "unlimited" {
+  unlimited = true
+}
+`, general.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_invites.all", "guild_id", guild.ID),
+					tfresource.TestCheckResourceAttr("data.discord_invites.all", "invites.#", "4"),
+					tfresource.TestCheckResourceAttr("data.discord_invites.general", "invites.#", "2"),
+					tfresource.TestCheckResourceAttr("data.discord_invites.general", "guild_id", guild.ID),
+					tfresource.TestCheckResourceAttr("data.discord_invites.expired", "invites.#", "2"),
+					tfresource.TestCheckResourceAttr("data.discord_invites.expired", "invites.0.code", "stale"),
+					tfresource.TestCheckResourceAttr("data.discord_invites.expired", "invites.0.expired", "true"),
+					tfresource.TestCheckResourceAttrSet("data.discord_invites.expired", "invites.0.expires_at"),
+					tfresource.TestCheckResourceAttr("data.discord_invites.expired", "invites.1.code", "used-up"),
+					tfresource.TestCheckResourceAttr("data.discord_invites.unlimited", "invites.#", "1"),
+					tfresource.TestCheckResourceAttr("data.discord_invites.unlimited", "invites.0.code", "forever"),
+					tfresource.TestCheckResourceAttr("data.discord_invites.unlimited", "invites.0.inviter_id", moderator.ID),
+					tfresource.TestCheckResourceAttr("data.discord_invites.unlimited", "invites.0.uses", "42"),
+					tfresource.TestCheckResourceAttr("data.discord_invites.unlimited", "invites.0.channel_id", general.ID),
+					tfresource.TestCheckResourceAttr("data.discord_invites.unlimited", "invites.0.url", "https://discord.gg/forever"),
+					tfresource.TestCheckNoResourceAttr("data.discord_invites.unlimited", "invites.0.expires_at"),
+				),
+			},
+		},
+	})
+}
@@ -17,6 +17,8 @@ var _ datasource.DataSource = &memberDataSource{}
 // memberDataSource defines the data source implementation.
 type memberDataSource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // memberDataSourceModel describes the data source data model.
@@ -60,8 +62,9 @@ func (d *memberDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Required:    true,
 			},
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the guild (server) where the member is located.",
-				Required:    true,
+				Description: "The ID of the guild (server) where the member is located. Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"id": schema.StringAttribute{
 				Description: "The ID of the member (same as user_id).",
@@ -134,16 +137,17 @@ func (d *memberDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -166,6 +170,7 @@ func (d *memberDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	userID := data.UserID.ValueString()
+	data.GuildID = guildIDOrDefault(data.GuildID, d.defaultGuildID)
 	guildID := data.GuildID.ValueString()
 
 	if userID == "" {
@@ -179,35 +184,17 @@ func (d *memberDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if guildID == "" {
 		resp.Diagnostics.AddError(
 			"Missing Guild ID",
-			"The guild_id attribute is required.",
+			missingGuildIDDetail,
 		)
 		return
 	}
 
 	// Fetch the member from the guild
-	member, err := d.client.GuildMember(guildID, userID)
+	member, err := d.client.GuildMember(guildID, userID, discordgo.WithContext(ctx))
 	if err != nil {
-		errorMsg := fmt.Sprintf("Unable to fetch member %s from guild %s: %s", userID, guildID, err.Error())
-
-		// Check for common permission/intent errors
-		if err.Error() == "HTTP 403 Forbidden, {\"message\": \"Missing Access\", \"code\": 50001}" ||
-			err.Error() == "HTTP 403 Forbidden" {
-			errorMsg += "\n\nThis error typically indicates that the bot is missing the GUILD_MEMBERS privileged intent." +
-				"\n\nTo fix this:" +
-				"\n1. Go to https://discord.com/developers/applications" +
-				"\n2. Select your bot application" +
-				"\n3. Go to the 'Bot' section" +
-				"\n4. Scroll down to 'Privileged Gateway Intents'" +
-				"\n5. Enable 'SERVER MEMBERS INTENT' (GUILD_MEMBERS)" +
-				"\n6. Save changes" +
-				"\n7. Restart your bot/application" +
-				"\n\nNote: This is a Discord API requirement, not just a permission issue. Even with Administrator permissions," +
-				" the privileged intent must be enabled in the Developer Portal."
-		}
-
 		resp.Diagnostics.AddError(
 			"Error Fetching Member",
-			errorMsg,
+			discordMembersErrorDetail(fmt.Sprintf("Unable to fetch member %s from guild %s", userID, guildID), err),
 		)
 		return
 	}
@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -17,12 +19,32 @@ var _ datasource.DataSource = &membersDataSource{}
 // membersDataSource defines the data source implementation.
 type membersDataSource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // membersDataSourceModel describes the data source data model.
 type membersDataSourceModel struct {
-	GuildID types.String `tfsdk:"guild_id"`
-	Members types.List   `tfsdk:"members"`
+	GuildID      types.String `tfsdk:"guild_id"`
+	RoleIDs      types.List   `tfsdk:"role_ids"`
+	RoleMatch    types.String `tfsdk:"role_match"`
+	Bot          types.Bool   `tfsdk:"bot"`
+	Query        types.String `tfsdk:"query"`
+	JoinedBefore types.String `tfsdk:"joined_before"`
+	JoinedAfter  types.String `tfsdk:"joined_after"`
+	Limit        types.Int64  `tfsdk:"limit"`
+	TotalCount   types.Int64  `tfsdk:"total_count"`
+	Truncated    types.Bool   `tfsdk:"truncated"`
+	Members      types.List   `tfsdk:"members"`
+}
+
+// membersFilter holds the member filters of the data source.
+type membersFilter struct {
+	roleIDs      []string
+	matchAll     bool
+	bot          *bool
+	joinedBefore time.Time
+	joinedAfter  time.Time
 }
 
 // memberModel describes a single member in the data source.
@@ -49,6 +71,65 @@ func NewMembersDataSource() datasource.DataSource {
 	return &membersDataSource{}
 }
 
+// filterMembers returns the members that match every configured filter.
+func filterMembers(members []*discordgo.Member, filter membersFilter) []*discordgo.Member {
+	matched := make([]*discordgo.Member, 0, len(members))
+	for _, member := range members {
+		if member.User == nil {
+			continue
+		}
+
+		if filter.bot != nil && member.User.Bot != *filter.bot {
+			continue
+		}
+
+		if !filter.joinedBefore.IsZero() && !member.JoinedAt.Before(filter.joinedBefore) {
+			continue
+		}
+
+		if !filter.joinedAfter.IsZero() && !member.JoinedAt.After(filter.joinedAfter) {
+			continue
+		}
+
+		if len(filter.roleIDs) > 0 {
+			held := 0
+			for _, roleID := range filter.roleIDs {
+				for _, memberRoleID := range member.Roles {
+					if memberRoleID == roleID {
+						held++
+						break
+					}
+				}
+			}
+
+			if held == 0 || (filter.matchAll && held < len(filter.roleIDs)) {
+				continue
+			}
+		}
+
+		matched = append(matched, member)
+	}
+
+	return matched
+}
+
+// fetchMatchingGuildMembers pages through the members of a guild like fetchAllGuildMembers, but stops once more
+// than limit members match filter, which is enough to know the result is truncated. A limit of 0 fetches every
+// member.
+func fetchMatchingGuildMembers(ctx context.Context, client *discordgo.Session, guildID string, filter membersFilter, limit int) ([]*discordgo.Member, error) {
+	var all []*discordgo.Member
+	matched := 0
+	err := pageGuildMembers(ctx, client, guildID, func(page []*discordgo.Member) bool {
+		all = append(all, page...)
+		matched += len(filterMembers(page, filter))
+		return limit == 0 || matched <= limit
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
 // Metadata returns the data source type name.
 func (d *membersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_members"
@@ -57,11 +138,52 @@ func (d *membersDataSource) Metadata(_ context.Context, req datasource.MetadataR
 // Schema defines the schema for the data source.
 func (d *membersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Retrieves all members from a Discord guild (server). Note: This may take time for large servers as it fetches members in batches.",
+		Description: "Retrieves all members from a Discord guild (server), optionally filtered by role, bot status, name prefix or join date. " +
+			"Note: This may take time for large servers as it pages through members in batches of 1000.",
 		Attributes: map[string]schema.Attribute{
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the Discord guild (server).",
-				Required:    true,
+				Description: "The ID of the Discord guild (server). Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"role_ids": schema.ListAttribute{
+				Description: "Only return members that hold these roles. See role_match for how multiple roles are combined.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"role_match": schema.StringAttribute{
+				Description: "How role_ids are matched. Valid values: \"any\" (the member holds at least one of the roles) and \"all\" (the member holds every role). Defaults to \"any\".",
+				Optional:    true,
+			},
+			"bot": schema.BoolAttribute{
+				Description: "If true, only return bot members. If false, only return human members. If unset, return both.",
+				Optional:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "Only return members whose username or nickname starts with this string. " +
+					"Uses Discord's member search endpoint, which returns at most 1000 members.",
+				Optional: true,
+			},
+			"joined_before": schema.StringAttribute{
+				Description: "Only return members that joined before this time (RFC 3339 timestamp, e.g. 2024-01-01T00:00:00Z).",
+				Optional:    true,
+			},
+			"joined_after": schema.StringAttribute{
+				Description: "Only return members that joined after this time (RFC 3339 timestamp, e.g. 2024-01-01T00:00:00Z).",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of members to return. If unset, every matching member is returned.",
+				Optional:    true,
+			},
+			"total_count": schema.Int64Attribute{
+				Description: "The number of members that matched the filters, before limit was applied. " +
+					"When limit is set, members are fetched only until more than limit of them match, so on large guilds this can be lower than the full number of matches.",
+				Computed: true,
+			},
+			"truncated": schema.BoolAttribute{
+				Description: "Whether the members list is partial, either because limit was reached or because the member search returned its maximum of 1000 results.",
+				Computed:    true,
 			},
 			"members": schema.ListNestedAttribute{
 				Description: "List of members in the guild.",
@@ -142,16 +264,17 @@ func (d *membersDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -173,57 +296,121 @@ func (d *membersDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	data.GuildID = guildIDOrDefault(data.GuildID, d.defaultGuildID)
 	guildID := data.GuildID.ValueString()
 	if guildID == "" {
 		resp.Diagnostics.AddError(
 			"Missing Guild ID",
-			"The guild_id attribute is required.",
+			missingGuildIDDetail,
 		)
 		return
 	}
 
-	// Fetch all members for the guild
-	// Note: GuildMembers() fetches members in batches and may take time for large servers
-	// The limit parameter controls how many members to fetch per request (max 1000)
-	// After parameter is used for pagination
-	members, err := d.client.GuildMembers(guildID, "", 1000)
-	if err != nil {
-		errorMsg := fmt.Sprintf("Unable to fetch members for guild %s: %s", guildID, err.Error())
-
-		// Check for common permission/intent errors
-		if err.Error() == "HTTP 403 Forbidden, {\"message\": \"Missing Access\", \"code\": 50001}" ||
-			err.Error() == "HTTP 403 Forbidden" {
-			errorMsg += "\n\nThis error typically indicates that the bot is missing the GUILD_MEMBERS privileged intent." +
-				"\n\nTo fix this:" +
-				"\n1. Go to https://discord.com/developers/applications" +
-				"\n2. Select your bot application" +
-				"\n3. Go to the 'Bot' section" +
-				"\n4. Scroll down to 'Privileged Gateway Intents'" +
-				"\n5. Enable 'SERVER MEMBERS INTENT' (GUILD_MEMBERS)" +
-				"\n6. Save changes" +
-				"\n7. Restart your bot/application" +
-				"\n\nNote: This is a Discord API requirement, not just a permission issue. Even with Administrator permissions," +
-				" the privileged intent must be enabled in the Developer Portal."
+	// Build the member filters
+	filter := membersFilter{}
+
+	if !data.RoleIDs.IsNull() && !data.RoleIDs.IsUnknown() {
+		resp.Diagnostics.Append(data.RoleIDs.ElementsAs(ctx, &filter.roleIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	switch data.RoleMatch.ValueString() {
+	case "", "any":
+	case "all":
+		filter.matchAll = true
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid Role Match",
+			fmt.Sprintf("Invalid role_match: %s. Valid values are: any, all", data.RoleMatch.ValueString()),
+		)
+		return
+	}
+
+	if !data.Bot.IsNull() && !data.Bot.IsUnknown() {
+		bot := data.Bot.ValueBool()
+		filter.bot = &bot
+	}
+
+	for _, bound := range []struct {
+		name  string
+		value types.String
+		dest  *time.Time
+	}{
+		{"joined_before", data.JoinedBefore, &filter.joinedBefore},
+		{"joined_after", data.JoinedAfter, &filter.joinedAfter},
+	} {
+		if bound.value.IsNull() || bound.value.IsUnknown() {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, bound.value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Timestamp",
+				fmt.Sprintf("The %s attribute must be an RFC 3339 timestamp (e.g. 2024-01-01T00:00:00Z): %s", bound.name, err.Error()),
+			)
+			return
 		}
+		*bound.dest = parsed
+	}
+
+	limit := 0
+	if !data.Limit.IsNull() && !data.Limit.IsUnknown() {
+		if data.Limit.ValueInt64() < 1 {
+			resp.Diagnostics.AddError(
+				"Invalid Limit",
+				"The limit attribute must be at least 1.",
+			)
+			return
+		}
+		limit = int(data.Limit.ValueInt64())
+	}
 
+	// Fetch the members for the guild
+	// A query uses the search endpoint (max 1000 results), otherwise every member is paged through
+	// using the after cursor, which may take time for large servers
+	var members []*discordgo.Member
+	var err error
+	truncated := false
+
+	query := strings.TrimSpace(data.Query.ValueString())
+	if query != "" {
+		members, err = d.client.GuildMembersSearch(guildID, query, guildMembersPageSize, discordgo.WithContext(ctx))
+		truncated = len(members) >= guildMembersPageSize
+	} else {
+		members, err = fetchMatchingGuildMembers(ctx, d.client, guildID, filter, limit)
+	}
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Members",
-			errorMsg,
+			discordMembersErrorDetail(fmt.Sprintf("Unable to fetch members for guild %s", guildID), err),
 		)
 		return
 	}
 
-	// Convert Discord members to Terraform model
-	memberList := make([]memberModel, 0, len(members))
+	// Skip members with nil User (shouldn't happen, but be defensive)
 	for _, member := range members {
-		// Skip members with nil User (shouldn't happen, but be defensive)
 		if member.User == nil {
 			resp.Diagnostics.AddWarning(
 				"Skipping Member with Nil User",
 				fmt.Sprintf("Skipping a member in guild %s because the User field is nil. This may indicate a Discord API issue.", guildID),
 			)
-			continue
 		}
+	}
+
+	// Apply the filters, then the limit cap
+	members = filterMembers(members, filter)
+	data.TotalCount = types.Int64Value(int64(len(members)))
+	if limit > 0 && len(members) > limit {
+		members = members[:limit]
+		truncated = true
+	}
+	data.Truncated = types.BoolValue(truncated)
+
+	// Convert Discord members to Terraform model
+	memberList := make([]memberModel, 0, len(members))
+	for _, member := range members {
 
 		memberModel := memberModel{
 			ID:       types.StringValue(member.User.ID),
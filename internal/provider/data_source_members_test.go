@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMembersDataSource_Schema(t *testing.T) {
+	d := NewMembersDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	d.Schema(t.Context(), req, resp)
+
+	assert.NotNil(t, resp.Schema)
+	assert.Contains(t, resp.Schema.Description, "Retrieves all members")
+
+	// Check optional filter attributes
+	optionalAttrs := []string{"role_ids", "role_match", "bot", "query", "joined_before", "joined_after", "limit"}
+	for _, attrName := range optionalAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsOptional(), "Attribute %s should be optional", attrName)
+	}
+
+	// Check computed attributes
+	computedAttrs := []string{"total_count", "truncated", "members"}
+	for _, attrName := range computedAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsComputed(), "Attribute %s should be computed", attrName)
+	}
+}
+
+func TestFilterMembers(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	jun := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	members := []*discordgo.Member{
+		{User: &discordgo.User{ID: "1"}, Roles: []string{"a", "b"}, JoinedAt: jan},
+		{User: &discordgo.User{ID: "2", Bot: true}, Roles: []string{"a"}, JoinedAt: jun},
+		{User: &discordgo.User{ID: "3"}, Roles: []string{}, JoinedAt: jun},
+		{User: nil},
+	}
+
+	ids := func(members []*discordgo.Member) []string {
+		out := make([]string, 0, len(members))
+		for _, m := range members {
+			out = append(out, m.User.ID)
+		}
+		return out
+	}
+
+	human := false
+	bot := true
+
+	tests := []struct {
+		name     string
+		filter   membersFilter
+		expected []string
+	}{
+		{
+			name:     "no filters",
+			filter:   membersFilter{},
+			expected: []string{"1", "2", "3"},
+		},
+		{
+			name:     "any role",
+			filter:   membersFilter{roleIDs: []string{"a", "b"}},
+			expected: []string{"1", "2"},
+		},
+		{
+			name:     "all roles",
+			filter:   membersFilter{roleIDs: []string{"a", "b"}, matchAll: true},
+			expected: []string{"1"},
+		},
+		{
+			name:     "humans only",
+			filter:   membersFilter{bot: &human},
+			expected: []string{"1", "3"},
+		},
+		{
+			name:     "bots only",
+			filter:   membersFilter{bot: &bot},
+			expected: []string{"2"},
+		},
+		{
+			name:     "joined after",
+			filter:   membersFilter{joinedAfter: jan},
+			expected: []string{"2", "3"},
+		},
+		{
+			name:     "joined before",
+			filter:   membersFilter{joinedBefore: jun},
+			expected: []string{"1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ids(filterMembers(members, tt.filter)))
+		})
+	}
+}
+
+func TestFetchMatchingGuildMembers(t *testing.T) {
+	s, _ := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	for i := 0; i < guildMembersPageSize+500; i++ {
+		s.AddMember(guild.ID, s.AddUser(fmt.Sprintf("user%d", i)))
+	}
+	client := testFakeClient(t, s)
+
+	memberPages := func() int {
+		pages := 0
+		for _, request := range s.Requests() {
+			if strings.HasPrefix(request, "GET /guilds/"+guild.ID+"/members") {
+				pages++
+			}
+		}
+		return pages
+	}
+
+	// The first page already holds more than limit matches, so the second is never fetched
+	members, err := fetchMatchingGuildMembers(t.Context(), client, guild.ID, membersFilter{}, 5)
+	require.NoError(t, err)
+	assert.Len(t, members, guildMembersPageSize)
+	assert.Equal(t, 1, memberPages())
+
+	// Without a limit every page is fetched
+	members, err = fetchMatchingGuildMembers(t.Context(), client, guild.ID, membersFilter{}, 0)
+	require.NoError(t, err)
+	// The guild also has its owner and the bot
+	assert.Len(t, members, guildMembersPageSize+502)
+	assert.Equal(t, 3, memberPages())
+}
@@ -3,33 +3,46 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the data source type implements the required interfaces.
 var _ datasource.DataSource = &roleDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &roleDataSource{}
 
 // roleDataSource defines the data source implementation.
 type roleDataSource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // roleDataSourceModel describes the data source data model.
 type roleDataSourceModel struct {
-	RoleID      types.String `tfsdk:"role_id"`
-	Name        types.String `tfsdk:"name"`
-	GuildID     types.String `tfsdk:"guild_id"`
-	ID          types.String `tfsdk:"id"`
-	Color       types.Int64  `tfsdk:"color"`
-	Position    types.Int64  `tfsdk:"position"`
-	Permissions types.Int64  `tfsdk:"permissions"`
-	Managed     types.Bool   `tfsdk:"managed"`
-	Mentionable types.Bool   `tfsdk:"mentionable"`
-	Hoist       types.Bool   `tfsdk:"hoist"`
+	RoleID            types.String `tfsdk:"role_id"`
+	Name              types.String `tfsdk:"name"`
+	NameRegex         types.String `tfsdk:"name_regex"`
+	GuildID           types.String `tfsdk:"guild_id"`
+	ID                types.String `tfsdk:"id"`
+	Color             types.Int64  `tfsdk:"color"`
+	Position          types.Int64  `tfsdk:"position"`
+	Permissions       types.Int64  `tfsdk:"permissions"`
+	Managed           types.Bool   `tfsdk:"managed"`
+	Mentionable       types.Bool   `tfsdk:"mentionable"`
+	Hoist             types.Bool   `tfsdk:"hoist"`
+	Icon              types.String `tfsdk:"icon"`
+	UnicodeEmoji      types.String `tfsdk:"unicode_emoji"`
+	BotID             types.String `tfsdk:"bot_id"`
+	IntegrationID     types.String `tfsdk:"integration_id"`
+	PremiumSubscriber types.Bool   `tfsdk:"premium_subscriber"`
 }
 
 // NewRoleDataSource is a helper function to simplify testing.
@@ -44,50 +57,49 @@ func (d *roleDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 
 // Schema defines the schema for the data source.
 func (d *roleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := roleComputedAttributes()
+	attributes["role_id"] = schema.StringAttribute{
+		Description: "The ID of the role to retrieve. One of role_id, name and name_regex must be set. Takes precedence over name when both are set.",
+		Optional:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "The exact name of the role to retrieve. If several roles have this name, the highest one is used. One of role_id, name and name_regex must be set.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["name_regex"] = schema.StringAttribute{
+		Description: "A regular expression (Go RE2 syntax) that the name of exactly one role in the guild matches. It is an error if several roles match. Conflicts with role_id and name.",
+		Optional:    true,
+	}
+	attributes["guild_id"] = schema.StringAttribute{
+		Description: "The ID of the guild (server) where the role is located. Defaults to the provider's guild_id.",
+		Optional:    true,
+		Computed:    true,
+	}
+
 	resp.Schema = schema.Schema{
-		Description: "Retrieves a Discord role. Can be looked up by ID or by name and guild ID.",
-		Attributes: map[string]schema.Attribute{
-			"role_id": schema.StringAttribute{
-				Description: "The ID of the role to retrieve. Either this or (name + guild_id) must be provided.",
-				Optional:    true,
-			},
-			"name": schema.StringAttribute{
-				Description: "The name of the role. Must be provided along with guild_id if role_id is not specified.",
-				Optional:    true,
-			},
-			"guild_id": schema.StringAttribute{
-				Description: "The ID of the guild (server) where the role is located. Must be provided along with name if role_id is not specified.",
-				Optional:    true,
-			},
-			"id": schema.StringAttribute{
-				Description: "The ID of the role.",
-				Computed:    true,
-			},
-			"color": schema.Int64Attribute{
-				Description: "The hex color of the role (as an integer).",
-				Computed:    true,
-			},
-			"position": schema.Int64Attribute{
-				Description: "The position of the role in the guild's role hierarchy.",
-				Computed:    true,
-			},
-			"permissions": schema.Int64Attribute{
-				Description: "The permissions integer for the role on the guild.",
-				Computed:    true,
-			},
-			"managed": schema.BoolAttribute{
-				Description: "Whether this role is managed by an integration.",
-				Computed:    true,
-			},
-			"mentionable": schema.BoolAttribute{
-				Description: "Whether this role is mentionable.",
-				Computed:    true,
-			},
-			"hoist": schema.BoolAttribute{
-				Description: "Whether this role is hoisted (shows up separately in member list).",
-				Computed:    true,
-			},
-		},
+		Description: "Retrieves a Discord role. Can be looked up by ID, by exact name, or by a name pattern that matches exactly one role.",
+		Attributes:  attributes,
+	}
+}
+
+// ConfigValidators requires a way of identifying the role. role_id and name may be set together, as they
+// always could, but name_regex must be used on its own.
+func (d *roleDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("role_id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("name_regex"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("name_regex"),
+			path.MatchRoot("role_id"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("name_regex"),
+			path.MatchRoot("name"),
+		),
 	}
 }
 
@@ -97,16 +109,17 @@ func (d *roleDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -128,99 +141,111 @@ func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	roleID := data.RoleID.ValueString()
-	name := data.Name.ValueString()
+	data.GuildID = guildIDOrDefault(data.GuildID, d.defaultGuildID)
 	guildID := data.GuildID.ValueString()
-
-	// Validate that either role_id or (name + guild_id) is provided
-	if roleID == "" && (name == "" || guildID == "") {
+	if guildID == "" {
 		resp.Diagnostics.AddError(
-			"Missing Required Attributes",
-			"Either role_id or both name and guild_id must be provided.",
+			"Missing Guild ID",
+			missingGuildIDDetail,
 		)
 		return
 	}
 
-	var role *discordgo.Role
-
-	if roleID != "" {
-		// Look up by role ID - we need to get the guild first to find the role
-		// Since we don't have a direct API to get role by ID, we'll need guild_id
-		// But if only role_id is provided, we can try to find it by searching guilds
-		// For now, let's require guild_id when using role_id for simplicity
-		if guildID == "" {
-			resp.Diagnostics.AddError(
-				"Missing Guild ID",
-				"When using role_id, guild_id is also required.",
-			)
-			return
-		}
-
-		// Fetch all roles and find the one with matching ID
-		roles, err := d.client.GuildRoles(guildID)
+	// Compile name_regex before any request is made, so an invalid pattern fails fast
+	var nameRegex *regexp.Regexp
+	if data.RoleID.IsNull() && data.Name.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Fetching Roles",
-				fmt.Sprintf("Unable to fetch roles for guild %s: %s", guildID, err.Error()),
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				fmt.Sprintf("The name_regex attribute must be a valid regular expression: %s", err.Error()),
 			)
 			return
 		}
+	}
+
+	// Discord has no endpoint for a single role, so every lookup searches the guild's roles
+	roles, err := fetchGuildRoles(ctx, d.client, guildID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Roles",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles for guild %s", guildID), err),
+		)
+		return
+	}
 
-		found := false
+	var role *discordRole
+	switch {
+	case !data.RoleID.IsNull():
+		// role_id takes precedence when name is also set
+		roleID := data.RoleID.ValueString()
 		for _, r := range roles {
 			if r.ID == roleID {
 				role = r
-				found = true
 				break
 			}
 		}
-
-		if !found {
+		if role == nil {
 			resp.Diagnostics.AddError(
 				"Role Not Found",
 				fmt.Sprintf("Role with ID %s not found in guild %s", roleID, guildID),
 			)
 			return
 		}
-	} else {
-		// Look up by name and guild_id
-		roles, err := d.client.GuildRoles(guildID)
-		if err != nil {
+	case !data.Name.IsNull():
+		// Several roles can share a name, in which case the highest one is used
+		name := data.Name.ValueString()
+		matches := filterRoles(roles, rolesFilter{nameRegex: regexp.MustCompile("^" + regexp.QuoteMeta(name) + "$")})
+		if len(matches) == 0 {
 			resp.Diagnostics.AddError(
-				"Error Fetching Roles",
-				fmt.Sprintf("Unable to fetch roles for guild %s: %s", guildID, err.Error()),
+				"Role Not Found",
+				fmt.Sprintf("Role with name '%s' not found in guild %s", name, guildID),
 			)
 			return
 		}
-
-		found := false
-		for _, r := range roles {
-			if r.Name == name {
-				role = r
-				found = true
-				break
-			}
-		}
-
-		if !found {
+		role = matches[0]
+	default:
+		matches := filterRoles(roles, rolesFilter{nameRegex: nameRegex})
+		switch len(matches) {
+		case 0:
 			resp.Diagnostics.AddError(
 				"Role Not Found",
-				fmt.Sprintf("Role with name '%s' not found in guild %s", name, guildID),
+				fmt.Sprintf("No role with a name matching '%s' was found in guild %s", data.NameRegex.ValueString(), guildID),
+			)
+			return
+		case 1:
+			role = matches[0]
+		default:
+			ids := make([]string, 0, len(matches))
+			for _, r := range matches {
+				ids = append(ids, r.ID)
+			}
+			resp.Diagnostics.AddError(
+				"Multiple Roles Found",
+				fmt.Sprintf("%d roles with a name matching '%s' were found in guild %s: %s. Narrow the pattern, or use role_id.",
+					len(matches), data.NameRegex.ValueString(), guildID, strings.Join(ids, ", ")),
 			)
 			return
 		}
 	}
 
 	// Populate the model with role data
-	data.ID = types.StringValue(role.ID)
-	data.Name = types.StringValue(role.Name)
-	data.Color = types.Int64Value(int64(role.Color))
-	data.Position = types.Int64Value(int64(role.Position))
-	data.Permissions = types.Int64Value(role.Permissions)
-	data.Managed = types.BoolValue(role.Managed)
-	data.Mentionable = types.BoolValue(role.Mentionable)
-	data.Hoist = types.BoolValue(role.Hoist)
-	data.GuildID = types.StringValue(guildID)
+	model := newRoleModel(role)
+	data.ID = model.ID
+	data.Name = model.Name
+	data.Color = model.Color
+	data.Position = model.Position
+	data.Permissions = model.Permissions
+	data.Managed = model.Managed
+	data.Mentionable = model.Mentionable
+	data.Hoist = model.Hoist
+	data.Icon = model.Icon
+	data.UnicodeEmoji = model.UnicodeEmoji
+	data.BotID = model.BotID
+	data.IntegrationID = model.IntegrationID
+	data.PremiumSubscriber = model.PremiumSubscriber
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
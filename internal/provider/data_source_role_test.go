@@ -1,11 +1,15 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
+	"github.com/tfstack/terraform-provider-discord/internal/fakediscord"
 )
 
 func TestRoleDataSource_Metadata(t *testing.T) {
@@ -39,10 +43,20 @@ func TestRoleDataSource_Schema(t *testing.T) {
 	assert.True(t, ok)
 	assert.True(t, nameAttr.IsOptional())
 
+	nameRegexAttr, ok := resp.Schema.Attributes["name_regex"]
+	assert.True(t, ok)
+	assert.True(t, nameRegexAttr.IsOptional())
+
 	// Check computed attributes
 	idAttr, ok := resp.Schema.Attributes["id"]
 	assert.True(t, ok)
 	assert.True(t, idAttr.IsComputed())
+
+	for _, name := range []string{"icon", "unicode_emoji", "bot_id", "integration_id", "premium_subscriber"} {
+		attr, ok := resp.Schema.Attributes[name]
+		assert.True(t, ok, name)
+		assert.True(t, attr.IsComputed(), name)
+	}
 }
 
 func TestRoleDataSource_Configure(t *testing.T) {
@@ -53,8 +67,8 @@ func TestRoleDataSource_Configure(t *testing.T) {
 		errorContains string
 	}{
 		{
-			name:         "valid discordgo.Session",
-			providerData: &discordgo.Session{},
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
 			expectError:  false,
 		},
 		{
@@ -91,3 +105,114 @@ func TestRoleDataSource_Configure(t *testing.T) {
 		})
 	}
 }
+
+func TestAccRoleDataSource(t *testing.T) {
+	s, _ := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+
+	moderators := s.AddRole(guild.ID, "Moderators")
+	booster := s.AddManagedRole(guild.ID, "Server Booster", fakediscord.RoleTags{PremiumSubscriber: true})
+	twitch := s.AddManagedRole(guild.ID, "Twitch Subscriber", fakediscord.RoleTags{IntegrationID: "123456789012345678"})
+	seniorModerators := s.AddRole(guild.ID, "Moderators")
+	s.EditRole(guild.ID, seniorModerators.ID, func(r *discordgo.Role) { r.Position = 10 })
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfigWithGuild(s, guild.ID) + fmt.Sprintf(`
+data "discord_role" "by_id" {
+  role_id = %q
+}
+
+data "discord_role" "by_name" {
+  name = "Server Booster"
+}
+
+data "discord_role" "by_name_regex" {
+  name_regex = "^Twitch"
+}
+`, moderators.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_role.by_id", "name", "Moderators"),
+					tfresource.TestCheckResourceAttr("data.discord_role.by_id", "guild_id", guild.ID),
+					tfresource.TestCheckResourceAttr("data.discord_role.by_id", "managed", "false"),
+					tfresource.TestCheckResourceAttr("data.discord_role.by_name", "id", booster.ID),
+					tfresource.TestCheckResourceAttr("data.discord_role.by_name", "premium_subscriber", "true"),
+					tfresource.TestCheckResourceAttr("data.discord_role.by_name_regex", "id", twitch.ID),
+					tfresource.TestCheckResourceAttr("data.discord_role.by_name_regex", "name", "Twitch Subscriber"),
+					tfresource.TestCheckResourceAttr("data.discord_role.by_name_regex", "integration_id", "123456789012345678"),
+				),
+			},
+			{
+				Config: testAccProviderConfigWithGuild(s, guild.ID) + `
+data "discord_role" "test" {
+  name_regex = "er$"
+}
+`,
+				ExpectError: regexp.MustCompile(`Multiple Roles Found`),
+			},
+			{
+				// A name shared by several roles resolves to the highest one, and role_id wins over name
+				Config: testAccProviderConfigWithGuild(s, guild.ID) + fmt.Sprintf(`
+data "discord_role" "by_name" {
+  name = "Moderators"
+}
+
+data "discord_role" "by_id_and_name" {
+  role_id = %q
+  name    = "Moderators"
+}
+`, moderators.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_role.by_name", "id", seniorModerators.ID),
+					tfresource.TestCheckResourceAttr("data.discord_role.by_name", "position", "10"),
+					tfresource.TestCheckResourceAttr("data.discord_role.by_id_and_name", "id", moderators.ID),
+				),
+			},
+			{
+				Config: testAccProviderConfigWithGuild(s, guild.ID) + `
+data "discord_role" "test" {
+  name = "Moderator"
+}
+`,
+				ExpectError: regexp.MustCompile(`Role Not Found`),
+			},
+		},
+	})
+}
+
+func TestAccRoleDataSource_invalidConfig(t *testing.T) {
+	s, _ := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfigWithGuild(s, guild.ID) + `
+data "discord_role" "test" {
+  name       = "Moderators"
+  name_regex = "^Mod"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccProviderConfigWithGuild(s, guild.ID) + `
+data "discord_role" "test" {
+  role_id    = "123456789012345678"
+  name_regex = "^Mod"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccProviderConfigWithGuild(s, guild.ID) + `
+data "discord_role" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Missing Attribute Configuration`),
+			},
+		},
+	})
+}
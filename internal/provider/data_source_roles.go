@@ -2,12 +2,18 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,24 +23,81 @@ var _ datasource.DataSource = &rolesDataSource{}
 // rolesDataSource defines the data source implementation.
 type rolesDataSource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // rolesDataSourceModel describes the data source data model.
 type rolesDataSourceModel struct {
-	GuildID types.String `tfsdk:"guild_id"`
-	Roles   types.List   `tfsdk:"roles"`
+	GuildID       types.String `tfsdk:"guild_id"`
+	NameRegex     types.String `tfsdk:"name_regex"`
+	Managed       types.Bool   `tfsdk:"managed"`
+	HasPermission types.String `tfsdk:"has_permission"`
+	Mentionable   types.Bool   `tfsdk:"mentionable"`
+	Hoist         types.Bool   `tfsdk:"hoist"`
+	Roles         types.List   `tfsdk:"roles"`
+}
+
+// rolesFilter holds the role filters of the data source.
+type rolesFilter struct {
+	nameRegex     *regexp.Regexp
+	managed       *bool
+	hasPermission int64
+	mentionable   *bool
+	hoist         *bool
 }
 
 // roleModel describes a single role in the data source.
 type roleModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Color       types.Int64  `tfsdk:"color"`
-	Position    types.Int64  `tfsdk:"position"`
-	Permissions types.Int64  `tfsdk:"permissions"`
-	Managed     types.Bool   `tfsdk:"managed"`
-	Mentionable types.Bool   `tfsdk:"mentionable"`
-	Hoist       types.Bool   `tfsdk:"hoist"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Color             types.Int64  `tfsdk:"color"`
+	Position          types.Int64  `tfsdk:"position"`
+	Permissions       types.Int64  `tfsdk:"permissions"`
+	Managed           types.Bool   `tfsdk:"managed"`
+	Mentionable       types.Bool   `tfsdk:"mentionable"`
+	Hoist             types.Bool   `tfsdk:"hoist"`
+	Icon              types.String `tfsdk:"icon"`
+	UnicodeEmoji      types.String `tfsdk:"unicode_emoji"`
+	BotID             types.String `tfsdk:"bot_id"`
+	IntegrationID     types.String `tfsdk:"integration_id"`
+	PremiumSubscriber types.Bool   `tfsdk:"premium_subscriber"`
+}
+
+// roleAttributeTypes are the attribute types of roleModel.
+var roleAttributeTypes = map[string]attr.Type{
+	"id":                 types.StringType,
+	"name":               types.StringType,
+	"color":              types.Int64Type,
+	"position":           types.Int64Type,
+	"permissions":        types.Int64Type,
+	"managed":            types.BoolType,
+	"mentionable":        types.BoolType,
+	"hoist":              types.BoolType,
+	"icon":               types.StringType,
+	"unicode_emoji":      types.StringType,
+	"bot_id":             types.StringType,
+	"integration_id":     types.StringType,
+	"premium_subscriber": types.BoolType,
+}
+
+// discordRole is a role as Discord returns it. discordgo.Role lacks the tags of managed roles.
+type discordRole struct {
+	discordgo.Role
+	Tags *struct {
+		BotID             string      `json:"bot_id"`
+		IntegrationID     string      `json:"integration_id"`
+		PremiumSubscriber roleTagFlag `json:"premium_subscriber"`
+	} `json:"tags"`
+}
+
+// roleTagFlag is a boolean role tag. Discord sends it as null when it is set and leaves it out otherwise.
+type roleTagFlag bool
+
+// UnmarshalJSON sets the flag whenever the tag is present, whatever its value.
+func (f *roleTagFlag) UnmarshalJSON([]byte) error {
+	*f = true
+	return nil
 }
 
 // NewRolesDataSource is a helper function to simplify testing.
@@ -42,6 +105,80 @@ func NewRolesDataSource() datasource.DataSource {
 	return &rolesDataSource{}
 }
 
+// fetchGuildRoles returns the roles of the guild with their tags.
+func fetchGuildRoles(ctx context.Context, client *discordgo.Session, guildID string) ([]*discordRole, error) {
+	endpoint := discordgo.EndpointGuildRoles(guildID)
+	body, err := client.RequestWithBucketID("GET", endpoint, nil, endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []*discordRole
+	if err := json.Unmarshal(body, &roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// filterRoles returns the roles that match every configured filter, highest in the hierarchy first.
+func filterRoles(roles []*discordRole, filter rolesFilter) []*discordRole {
+	matched := make([]*discordRole, 0, len(roles))
+	for _, role := range roles {
+		if filter.nameRegex != nil && !filter.nameRegex.MatchString(role.Name) {
+			continue
+		}
+		if filter.managed != nil && role.Managed != *filter.managed {
+			continue
+		}
+		// Administrators hold every permission, whatever their other flags
+		if filter.hasPermission != 0 && role.Permissions&(filter.hasPermission|discordgo.PermissionAdministrator) == 0 {
+			continue
+		}
+		if filter.mentionable != nil && role.Mentionable != *filter.mentionable {
+			continue
+		}
+		if filter.hoist != nil && role.Hoist != *filter.hoist {
+			continue
+		}
+
+		matched = append(matched, role)
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		if matched[i].Position != matched[j].Position {
+			return matched[i].Position > matched[j].Position
+		}
+		return snowflakeLess(matched[i].ID, matched[j].ID)
+	})
+
+	return matched
+}
+
+// newRoleModel converts a role to its data source model.
+func newRoleModel(role *discordRole) roleModel {
+	model := roleModel{
+		ID:                types.StringValue(role.ID),
+		Name:              types.StringValue(role.Name),
+		Color:             types.Int64Value(int64(role.Color)),
+		Position:          types.Int64Value(int64(role.Position)),
+		Permissions:       types.Int64Value(role.Permissions),
+		Managed:           types.BoolValue(role.Managed),
+		Mentionable:       types.BoolValue(role.Mentionable),
+		Hoist:             types.BoolValue(role.Hoist),
+		Icon:              stringValueOrNull(role.Icon),
+		UnicodeEmoji:      stringValueOrNull(role.UnicodeEmoji),
+		BotID:             types.StringNull(),
+		IntegrationID:     types.StringNull(),
+		PremiumSubscriber: types.BoolValue(false),
+	}
+	if role.Tags != nil {
+		model.BotID = stringValueOrNull(role.Tags.BotID)
+		model.IntegrationID = stringValueOrNull(role.Tags.IntegrationID)
+		model.PremiumSubscriber = types.BoolValue(bool(role.Tags.PremiumSubscriber))
+	}
+	return model
+}
+
 // Metadata returns the data source type name.
 func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_roles"
@@ -50,72 +187,123 @@ func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 // Schema defines the schema for the data source.
 func (d *rolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Retrieves all roles from a Discord guild (server).",
+		Description: "Retrieves all roles from a Discord guild (server), optionally filtered by name, management, permission, mentionability or hoisting. " +
+			"Roles are sorted by position, highest in the hierarchy first.",
 		Attributes: map[string]schema.Attribute{
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the Discord guild (server).",
-				Required:    true,
+				Description: "The ID of the Discord guild (server). Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return roles whose name matches this regular expression (Go RE2 syntax).",
+				Optional:    true,
+			},
+			"managed": schema.BoolAttribute{
+				Description: "If true, only return roles managed by an integration, a bot or server boosts. If false, only return roles that are not. If unset, return both.",
+				Optional:    true,
+			},
+			"has_permission": schema.StringAttribute{
+				Description: "Only return roles that grant this permission, by flag name (e.g. ADMINISTRATOR or MANAGE_ROLES). Roles with ADMINISTRATOR grant every permission.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(permissionFlagNames()...),
+				},
+			},
+			"mentionable": schema.BoolAttribute{
+				Description: "If set, only return roles whose mentionable flag equals this value.",
+				Optional:    true,
+			},
+			"hoist": schema.BoolAttribute{
+				Description: "If set, only return roles whose hoist flag equals this value.",
+				Optional:    true,
 			},
 			"roles": schema.ListNestedAttribute{
-				Description: "List of roles in the guild.",
+				Description: "List of roles in the guild that match the filters, highest position first.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Description: "The ID of the role.",
-							Computed:    true,
-						},
-						"name": schema.StringAttribute{
-							Description: "The name of the role.",
-							Computed:    true,
-						},
-						"color": schema.Int64Attribute{
-							Description: "The hex color of the role (as an integer).",
-							Computed:    true,
-						},
-						"position": schema.Int64Attribute{
-							Description: "The position of the role in the guild's role hierarchy.",
-							Computed:    true,
-						},
-						"permissions": schema.Int64Attribute{
-							Description: "The permissions integer for the role on the guild.",
-							Computed:    true,
-						},
-						"managed": schema.BoolAttribute{
-							Description: "Whether this role is managed by an integration.",
-							Computed:    true,
-						},
-						"mentionable": schema.BoolAttribute{
-							Description: "Whether this role is mentionable.",
-							Computed:    true,
-						},
-						"hoist": schema.BoolAttribute{
-							Description: "Whether this role is hoisted (shows up separately in member list).",
-							Computed:    true,
-						},
-					},
+					Attributes: roleComputedAttributes(),
 				},
 			},
 		},
 	}
 }
 
+// roleComputedAttributes returns the schema attributes of a single role.
+func roleComputedAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the role.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the role.",
+			Computed:    true,
+		},
+		"color": schema.Int64Attribute{
+			Description: "The hex color of the role (as an integer).",
+			Computed:    true,
+		},
+		"position": schema.Int64Attribute{
+			Description: "The position of the role in the guild's role hierarchy.",
+			Computed:    true,
+		},
+		"permissions": schema.Int64Attribute{
+			Description: "The permissions integer for the role on the guild.",
+			Computed:    true,
+		},
+		"managed": schema.BoolAttribute{
+			Description: "Whether this role is managed by an integration, a bot or server boosts.",
+			Computed:    true,
+		},
+		"mentionable": schema.BoolAttribute{
+			Description: "Whether this role is mentionable.",
+			Computed:    true,
+		},
+		"hoist": schema.BoolAttribute{
+			Description: "Whether this role is hoisted (shows up separately in member list).",
+			Computed:    true,
+		},
+		"icon": schema.StringAttribute{
+			Description: "The icon hash of the role, or null if it has none.",
+			Computed:    true,
+		},
+		"unicode_emoji": schema.StringAttribute{
+			Description: "The unicode emoji shown as the role's icon, or null if it has none.",
+			Computed:    true,
+		},
+		"bot_id": schema.StringAttribute{
+			Description: "The ID of the bot the role belongs to, or null if it is not a bot's role.",
+			Computed:    true,
+		},
+		"integration_id": schema.StringAttribute{
+			Description: "The ID of the integration that manages the role, or null if it is not an integration's role.",
+			Computed:    true,
+		},
+		"premium_subscriber": schema.BoolAttribute{
+			Description: "Whether this is the guild's booster role.",
+			Computed:    true,
+		},
+	}
+}
+
 // Configure sets up the data source with the provider's configured client.
 func (d *rolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -137,74 +325,67 @@ func (d *rolesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	data.GuildID = guildIDOrDefault(data.GuildID, d.defaultGuildID)
 	guildID := data.GuildID.ValueString()
 	if guildID == "" {
 		resp.Diagnostics.AddError(
 			"Missing Guild ID",
-			"The guild_id attribute is required.",
+			missingGuildIDDetail,
 		)
 		return
 	}
 
+	// Build the role filters
+	filter := rolesFilter{
+		hasPermission: permissionBit(data.HasPermission.ValueString()),
+	}
+
+	if !data.NameRegex.IsNull() && !data.NameRegex.IsUnknown() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				fmt.Sprintf("The name_regex attribute must be a valid regular expression: %s", err.Error()),
+			)
+			return
+		}
+		filter.nameRegex = nameRegex
+	}
+
+	if !data.Managed.IsNull() && !data.Managed.IsUnknown() {
+		managed := data.Managed.ValueBool()
+		filter.managed = &managed
+	}
+
+	if !data.Mentionable.IsNull() && !data.Mentionable.IsUnknown() {
+		mentionable := data.Mentionable.ValueBool()
+		filter.mentionable = &mentionable
+	}
+
+	if !data.Hoist.IsNull() && !data.Hoist.IsUnknown() {
+		hoist := data.Hoist.ValueBool()
+		filter.hoist = &hoist
+	}
+
 	// Fetch all roles for the guild
-	roles, err := d.client.GuildRoles(guildID)
+	roles, err := fetchGuildRoles(ctx, d.client, guildID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Roles",
-			fmt.Sprintf("Unable to fetch roles for guild %s: %s", guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles for guild %s", guildID), err),
 		)
 		return
 	}
 
 	// Convert Discord roles to Terraform model
+	roles = filterRoles(roles, filter)
 	roleList := make([]roleModel, 0, len(roles))
 	for _, role := range roles {
-		roleModel := roleModel{
-			ID:          types.StringValue(role.ID),
-			Name:        types.StringValue(role.Name),
-			Color:       types.Int64Value(int64(role.Color)),
-			Position:    types.Int64Value(int64(role.Position)),
-			Permissions: types.Int64Value(role.Permissions),
-			Managed:     types.BoolValue(role.Managed),
-			Mentionable: types.BoolValue(role.Mentionable),
-			Hoist:       types.BoolValue(role.Hoist),
-		}
-		roleList = append(roleList, roleModel)
-	}
-
-	// Convert to Terraform list
-	roleObjectType := map[string]attr.Type{
-		"id":          types.StringType,
-		"name":        types.StringType,
-		"color":       types.Int64Type,
-		"position":    types.Int64Type,
-		"permissions": types.Int64Type,
-		"managed":     types.BoolType,
-		"mentionable": types.BoolType,
-		"hoist":       types.BoolType,
-	}
-
-	roleListValue := make([]attr.Value, 0, len(roleList))
-	for _, role := range roleList {
-		roleMap := map[string]attr.Value{
-			"id":          role.ID,
-			"name":        role.Name,
-			"color":       role.Color,
-			"position":    role.Position,
-			"permissions": role.Permissions,
-			"managed":     role.Managed,
-			"mentionable": role.Mentionable,
-			"hoist":       role.Hoist,
-		}
-		roleObj, diags := types.ObjectValue(roleObjectType, roleMap)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		roleListValue = append(roleListValue, roleObj)
+		roleList = append(roleList, newRoleModel(role))
 	}
 
-	rolesValue, diags := types.ListValue(types.ObjectType{AttrTypes: roleObjectType}, roleListValue)
+	rolesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: roleAttributeTypes}, roleList)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"encoding/json"
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tfstack/terraform-provider-discord/internal/fakediscord"
 )
 
 func TestRolesDataSource_Metadata(t *testing.T) {
@@ -33,12 +39,19 @@ func TestRolesDataSource_Schema(t *testing.T) {
 	// Check required attribute
 	guildIDAttr, ok := resp.Schema.Attributes["guild_id"]
 	assert.True(t, ok)
-	assert.True(t, guildIDAttr.IsRequired())
+	assert.True(t, guildIDAttr.IsOptional(), "guild_id defaults to the provider's guild_id")
+	assert.True(t, guildIDAttr.IsComputed())
 
 	// Check computed attribute
 	rolesAttr, ok := resp.Schema.Attributes["roles"]
 	assert.True(t, ok)
 	assert.True(t, rolesAttr.IsComputed())
+
+	for _, name := range []string{"name_regex", "managed", "has_permission", "mentionable", "hoist"} {
+		attr, ok := resp.Schema.Attributes[name]
+		assert.True(t, ok, name)
+		assert.True(t, attr.IsOptional(), name)
+	}
 }
 
 func TestRolesDataSource_Configure(t *testing.T) {
@@ -49,8 +62,8 @@ func TestRolesDataSource_Configure(t *testing.T) {
 		errorContains string
 	}{
 		{
-			name:         "valid discordgo.Session",
-			providerData: &discordgo.Session{},
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
 			expectError:  false,
 		},
 		{
@@ -87,3 +100,148 @@ func TestRolesDataSource_Configure(t *testing.T) {
 		})
 	}
 }
+
+func TestDiscordRole_UnmarshalTags(t *testing.T) {
+	var roles []*discordRole
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"id": "1", "name": "Server Booster", "managed": true, "permissions": "0", "tags": {"premium_subscriber": null}},
+		{"id": "2", "name": "Bot", "managed": true, "permissions": "8", "tags": {"bot_id": "42"}},
+		{"id": "3", "name": "Members", "permissions": "0"}
+	]`), &roles))
+	require.Len(t, roles, 3)
+
+	booster := newRoleModel(roles[0])
+	assert.True(t, booster.PremiumSubscriber.ValueBool())
+	assert.True(t, booster.BotID.IsNull())
+
+	bot := newRoleModel(roles[1])
+	assert.False(t, bot.PremiumSubscriber.ValueBool())
+	assert.Equal(t, "42", bot.BotID.ValueString())
+	assert.Equal(t, int64(discordgo.PermissionAdministrator), bot.Permissions.ValueInt64())
+
+	members := newRoleModel(roles[2])
+	assert.False(t, members.PremiumSubscriber.ValueBool())
+	assert.True(t, members.IntegrationID.IsNull())
+}
+
+func TestFilterRoles(t *testing.T) {
+	roles := []*discordRole{
+		{Role: discordgo.Role{ID: "1", Name: "@everyone", Position: 0}},
+		{Role: discordgo.Role{ID: "2", Name: "Bot", Position: 3, Managed: true, Permissions: discordgo.PermissionAdministrator}},
+		{Role: discordgo.Role{ID: "3", Name: "Moderators", Position: 2, Hoist: true, Mentionable: true, Permissions: discordgo.PermissionManageRoles}},
+		{Role: discordgo.Role{ID: "4", Name: "Server Booster", Position: 1, Managed: true}},
+	}
+
+	ids := func(roles []*discordRole) []string {
+		out := make([]string, 0, len(roles))
+		for _, r := range roles {
+			out = append(out, r.ID)
+		}
+		return out
+	}
+
+	yes, no := true, false
+
+	assert.Equal(t, []string{"2", "3", "4", "1"}, ids(filterRoles(roles, rolesFilter{})))
+	assert.Equal(t, []string{"3", "4"}, ids(filterRoles(roles, rolesFilter{nameRegex: regexp.MustCompile(`^[MS]`)})))
+	assert.Equal(t, []string{"2", "4"}, ids(filterRoles(roles, rolesFilter{managed: &yes})))
+	assert.Equal(t, []string{"3", "1"}, ids(filterRoles(roles, rolesFilter{managed: &no})))
+	assert.Equal(t, []string{"2"}, ids(filterRoles(roles, rolesFilter{hasPermission: discordgo.PermissionAdministrator})))
+	// Administrators hold every permission
+	assert.Equal(t, []string{"2", "3"}, ids(filterRoles(roles, rolesFilter{hasPermission: discordgo.PermissionManageRoles})))
+	assert.Equal(t, []string{"3"}, ids(filterRoles(roles, rolesFilter{mentionable: &yes, hoist: &yes})))
+	assert.Equal(t, []string{"2", "4", "1"}, ids(filterRoles(roles, rolesFilter{hoist: &no})))
+}
+
+func TestAccRolesDataSource(t *testing.T) {
+	s, _ := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+
+	moderators := s.AddRole(guild.ID, "Moderators")
+	s.EditRole(guild.ID, moderators.ID, func(role *discordgo.Role) {
+		role.Permissions = discordgo.PermissionManageRoles | discordgo.PermissionKickMembers
+		role.Hoist = true
+		role.Mentionable = true
+		role.UnicodeEmoji = "🛡️"
+	})
+	booster := s.AddManagedRole(guild.ID, "Server Booster", fakediscord.RoleTags{PremiumSubscriber: true})
+	twitch := s.AddManagedRole(guild.ID, "Twitch Subscriber", fakediscord.RoleTags{IntegrationID: "123456789012345678"})
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfigWithGuild(s, guild.ID) + `
+data "discord_roles" "all" {}
+
+data "discord_roles" "managed" {
+  managed = true
+}
+
+data "discord_roles" "admins" {
+  has_permission = "ADMINISTRATOR"
+}
+
+data "discord_roles" "role_managers" {
+  has_permission = "MANAGE_ROLES"
+}
+
+data "discord_roles" "staff" {
+  name_regex  = "(?i)^mod"
+  hoist       = true
+  mentionable = true
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_roles.all", "guild_id", guild.ID),
+					tfresource.TestCheckResourceAttr("data.discord_roles.all", "roles.#", "5"),
+					tfresource.TestCheckResourceAttr("data.discord_roles.all", "roles.0.name", "Bot"),
+					tfresource.TestCheckResourceAttr("data.discord_roles.all", "roles.0.bot_id", s.BotUser.ID),
+					tfresource.TestCheckResourceAttr("data.discord_roles.all", "roles.1.id", moderators.ID),
+					tfresource.TestCheckResourceAttr("data.discord_roles.all", "roles.1.unicode_emoji", "🛡️"),
+					tfresource.TestCheckNoResourceAttr("data.discord_roles.all", "roles.1.bot_id"),
+					tfresource.TestCheckResourceAttr("data.discord_roles.all", "roles.4.name", "@everyone"),
+					tfresource.TestCheckResourceAttr("data.discord_roles.managed", "roles.#", "3"),
+					tfresource.TestCheckResourceAttr("data.discord_roles.managed", "roles.1.id", booster.ID),
+					tfresource.TestCheckResourceAttr("data.discord_roles.managed", "roles.1.premium_subscriber", "true"),
+					tfresource.TestCheckResourceAttr("data.discord_roles.managed", "roles.2.id", twitch.ID),
+					tfresource.TestCheckResourceAttr("data.discord_roles.managed", "roles.2.integration_id", "123456789012345678"),
+					tfresource.TestCheckResourceAttr("data.discord_roles.managed", "roles.2.premium_subscriber", "false"),
+					tfresource.TestCheckResourceAttr("data.discord_roles.admins", "roles.#", "1"),
+					tfresource.TestCheckResourceAttr("data.discord_roles.admins", "roles.0.name", "Bot"),
+					tfresource.TestCheckResourceAttr("data.discord_roles.role_managers", "roles.#", "2"),
+					tfresource.TestCheckResourceAttr("data.discord_roles.staff", "roles.#", "1"),
+					tfresource.TestCheckResourceAttr("data.discord_roles.staff", "roles.0.id", moderators.ID),
+					tfresource.TestCheckResourceAttr("data.discord_roles.staff", "roles.0.permissions", fmt.Sprint(discordgo.PermissionManageRoles|discordgo.PermissionKickMembers)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRolesDataSource_invalidConfig(t *testing.T) {
+	s, _ := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfigWithGuild(s, guild.ID) + `
+data "discord_roles" "test" {
+  has_permission = "MANAGE_EVERYTHING"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			{
+				Config: testAccProviderConfigWithGuild(s, guild.ID) + `
+data "discord_roles" "test" {
+  name_regex = "mod("
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Name Regex`),
+			},
+		},
+	})
+}
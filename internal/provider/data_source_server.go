@@ -16,17 +16,74 @@ var _ datasource.DataSource = &serverDataSource{}
 // serverDataSource defines the data source implementation.
 type serverDataSource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when server_id is not set.
+	defaultGuildID types.String
 }
 
 // serverDataSourceModel describes the data source data model.
 type serverDataSourceModel struct {
-	ServerID    types.String `tfsdk:"server_id"`
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Icon        types.String `tfsdk:"icon"`
-	Owner       types.Bool   `tfsdk:"owner"`
-	Permissions types.Int64  `tfsdk:"permissions"`
-	Features    types.List   `tfsdk:"features"`
+	ServerID                 types.String `tfsdk:"server_id"`
+	ID                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Icon                     types.String `tfsdk:"icon"`
+	Owner                    types.Bool   `tfsdk:"owner"`
+	Permissions              types.Int64  `tfsdk:"permissions"`
+	Features                 types.List   `tfsdk:"features"`
+	OwnerID                  types.String `tfsdk:"owner_id"`
+	ApproximateMemberCount   types.Int64  `tfsdk:"approximate_member_count"`
+	ApproximatePresenceCount types.Int64  `tfsdk:"approximate_presence_count"`
+	PremiumTier              types.Int64  `tfsdk:"premium_tier"`
+	PremiumSubscriptionCount types.Int64  `tfsdk:"premium_subscription_count"`
+	VerificationLevel        types.Int64  `tfsdk:"verification_level"`
+	ExplicitContentFilter    types.Int64  `tfsdk:"explicit_content_filter"`
+	AfkChannelID             types.String `tfsdk:"afk_channel_id"`
+	AfkTimeout               types.Int64  `tfsdk:"afk_timeout"`
+	SystemChannelID          types.String `tfsdk:"system_channel_id"`
+	RulesChannelID           types.String `tfsdk:"rules_channel_id"`
+	PublicUpdatesChannelID   types.String `tfsdk:"public_updates_channel_id"`
+	VanityURLCode            types.String `tfsdk:"vanity_url_code"`
+	MaxMembers               types.Int64  `tfsdk:"max_members"`
+	EmojiLimit               types.Int64  `tfsdk:"emoji_limit"`
+	StickerLimit             types.Int64  `tfsdk:"sticker_limit"`
+	BitrateLimit             types.Int64  `tfsdk:"bitrate_limit"`
+	PreferredLocale          types.String `tfsdk:"preferred_locale"`
+}
+
+// guildLimits are the emoji, sticker and voice bitrate limits of a guild.
+type guildLimits struct {
+	emojis   int
+	stickers int
+	bitrate  int
+}
+
+// premiumTierLimits are the limits Discord grants at each boost tier.
+var premiumTierLimits = map[discordgo.PremiumTier]guildLimits{
+	discordgo.PremiumTierNone: {emojis: 50, stickers: 5, bitrate: 96000},
+	discordgo.PremiumTier1:    {emojis: 100, stickers: 15, bitrate: 128000},
+	discordgo.PremiumTier2:    {emojis: 150, stickers: 30, bitrate: 256000},
+	discordgo.PremiumTier3:    {emojis: 250, stickers: 60, bitrate: 384000},
+}
+
+// guildLimitsFor returns the limits of a guild at its boost tier, raised by the features Discord grants to
+// some guilds regardless of tier.
+func guildLimitsFor(guild *discordgo.Guild) guildLimits {
+	limits, ok := premiumTierLimits[guild.PremiumTier]
+	if !ok {
+		limits = premiumTierLimits[discordgo.PremiumTier3]
+	}
+
+	for _, feature := range guild.Features {
+		switch feature {
+		case "MORE_EMOJI":
+			limits.emojis = max(limits.emojis, 200)
+		case discordgo.GuildFeatureMoreStickers:
+			limits.stickers = max(limits.stickers, 60)
+		case discordgo.GuildFeatureVipRegions:
+			limits.bitrate = max(limits.bitrate, 384000)
+		}
+	}
+
+	return limits
 }
 
 // NewServerDataSource is a helper function to simplify testing.
@@ -45,8 +102,9 @@ func (d *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 		Description: "Retrieves a single Discord server (guild) by its ID. The bot must be a member of the server.",
 		Attributes: map[string]schema.Attribute{
 			"server_id": schema.StringAttribute{
-				Description: "The ID of the Discord server (guild) to retrieve.",
-				Required:    true,
+				Description: "The ID of the Discord server (guild) to retrieve. Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"id": schema.StringAttribute{
 				Description: "The ID of the server (guild).",
@@ -73,6 +131,78 @@ func (d *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"owner_id": schema.StringAttribute{
+				Description: "The ID of the user that owns the server (guild).",
+				Computed:    true,
+			},
+			"approximate_member_count": schema.Int64Attribute{
+				Description: "The approximate number of members in the server (guild).",
+				Computed:    true,
+			},
+			"approximate_presence_count": schema.Int64Attribute{
+				Description: "The approximate number of online members in the server (guild).",
+				Computed:    true,
+			},
+			"premium_tier": schema.Int64Attribute{
+				Description: "The boost tier of the server (guild), from 0 (no boosts) to 3.",
+				Computed:    true,
+			},
+			"premium_subscription_count": schema.Int64Attribute{
+				Description: "The number of boosts the server (guild) currently has.",
+				Computed:    true,
+			},
+			"verification_level": schema.Int64Attribute{
+				Description: "The verification level required to chat. 0 = none, 1 = low (verified email), 2 = medium (registered for 5 minutes), 3 = high (member for 10 minutes), 4 = very high (verified phone).",
+				Computed:    true,
+			},
+			"explicit_content_filter": schema.Int64Attribute{
+				Description: "The explicit content filter level. 0 = disabled, 1 = members without roles, 2 = all members.",
+				Computed:    true,
+			},
+			"afk_channel_id": schema.StringAttribute{
+				Description: "The ID of the AFK voice channel, if set.",
+				Computed:    true,
+			},
+			"afk_timeout": schema.Int64Attribute{
+				Description: "The AFK timeout in seconds.",
+				Computed:    true,
+			},
+			"system_channel_id": schema.StringAttribute{
+				Description: "The ID of the channel where system messages such as member joins and boosts are posted, if set.",
+				Computed:    true,
+			},
+			"rules_channel_id": schema.StringAttribute{
+				Description: "The ID of the rules channel of a Community server (guild), if set.",
+				Computed:    true,
+			},
+			"public_updates_channel_id": schema.StringAttribute{
+				Description: "The ID of the channel where Community servers (guilds) receive notices from Discord, if set.",
+				Computed:    true,
+			},
+			"vanity_url_code": schema.StringAttribute{
+				Description: "The vanity invite code of the server (guild), if it has one.",
+				Computed:    true,
+			},
+			"max_members": schema.Int64Attribute{
+				Description: "The maximum number of members the server (guild) can have.",
+				Computed:    true,
+			},
+			"emoji_limit": schema.Int64Attribute{
+				Description: "The number of custom emojis (static and, separately, animated) the server (guild) can have at its boost tier.",
+				Computed:    true,
+			},
+			"sticker_limit": schema.Int64Attribute{
+				Description: "The number of custom stickers the server (guild) can have at its boost tier.",
+				Computed:    true,
+			},
+			"bitrate_limit": schema.Int64Attribute{
+				Description: "The highest voice channel bitrate in bits per second available at the server's (guild's) boost tier, e.g. 384000 at tier 3.",
+				Computed:    true,
+			},
+			"preferred_locale": schema.StringAttribute{
+				Description: "The preferred locale of a Community server (guild), e.g. en-US.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -83,16 +213,17 @@ func (d *serverDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -114,22 +245,24 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	data.ServerID = guildIDOrDefault(data.ServerID, d.defaultGuildID)
 	serverID := data.ServerID.ValueString()
 	if serverID == "" {
 		resp.Diagnostics.AddError(
 			"Missing Server ID",
-			"The server_id attribute is required.",
+			"The server_id attribute is required. Set server_id here, or set a default guild with guild_id in the "+
+				"provider configuration or the DISCORD_GUILD_ID environment variable.",
 		)
 		return
 	}
 
-	// Fetch the guild (server) by ID
-	// Note: Guild() requires the bot to be a member of the server
-	guild, err := d.client.Guild(serverID)
+	// Fetch the guild (server) by ID, with approximate member and presence counts
+	// Note: GuildWithCounts() requires the bot to be a member of the server
+	guild, err := d.client.GuildWithCounts(serverID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Server",
-			fmt.Sprintf("Unable to fetch server %s: %s", serverID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch server %s", serverID), err),
 		)
 		return
 	}
@@ -139,6 +272,27 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	data.Name = types.StringValue(guild.Name)
 	data.Owner = types.BoolValue(guild.Owner)
 	data.Permissions = types.Int64Value(guild.Permissions)
+	data.OwnerID = types.StringValue(guild.OwnerID)
+	data.ApproximateMemberCount = types.Int64Value(int64(guild.ApproximateMemberCount))
+	data.ApproximatePresenceCount = types.Int64Value(int64(guild.ApproximatePresenceCount))
+	data.PremiumTier = types.Int64Value(int64(guild.PremiumTier))
+	data.PremiumSubscriptionCount = types.Int64Value(int64(guild.PremiumSubscriptionCount))
+	data.VerificationLevel = types.Int64Value(int64(guild.VerificationLevel))
+	data.ExplicitContentFilter = types.Int64Value(int64(guild.ExplicitContentFilter))
+	data.AfkChannelID = stringValueOrNull(guild.AfkChannelID)
+	data.AfkTimeout = types.Int64Value(int64(guild.AfkTimeout))
+	data.SystemChannelID = stringValueOrNull(guild.SystemChannelID)
+	data.RulesChannelID = stringValueOrNull(guild.RulesChannelID)
+	data.PublicUpdatesChannelID = stringValueOrNull(guild.PublicUpdatesChannelID)
+	data.VanityURLCode = stringValueOrNull(guild.VanityURLCode)
+	data.MaxMembers = types.Int64Value(int64(guild.MaxMembers))
+	data.PreferredLocale = stringValueOrNull(guild.PreferredLocale)
+
+	// Limits that depend on the boost tier
+	limits := guildLimitsFor(guild)
+	data.EmojiLimit = types.Int64Value(int64(limits.emojis))
+	data.StickerLimit = types.Int64Value(int64(limits.stickers))
+	data.BitrateLimit = types.Int64Value(int64(limits.bitrate))
 
 	// Handle icon (can be empty string)
 	if guild.Icon != "" {
@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -30,10 +33,11 @@ func TestServerDataSource_Schema(t *testing.T) {
 	assert.NotNil(t, resp.Schema)
 	assert.Contains(t, resp.Schema.Description, "Retrieves a single Discord server")
 
-	// Check required attribute
+	// server_id defaults to the provider's guild_id
 	serverIDAttr, ok := resp.Schema.Attributes["server_id"]
 	assert.True(t, ok)
-	assert.True(t, serverIDAttr.IsRequired())
+	assert.True(t, serverIDAttr.IsOptional())
+	assert.True(t, serverIDAttr.IsComputed())
 
 	// Check computed attributes
 	idAttr, ok := resp.Schema.Attributes["id"]
@@ -43,6 +47,18 @@ func TestServerDataSource_Schema(t *testing.T) {
 	nameAttr, ok := resp.Schema.Attributes["name"]
 	assert.True(t, ok)
 	assert.True(t, nameAttr.IsComputed())
+
+	computedAttrs := []string{
+		"owner_id", "approximate_member_count", "approximate_presence_count", "premium_tier", "premium_subscription_count",
+		"verification_level", "explicit_content_filter", "afk_channel_id", "afk_timeout", "system_channel_id",
+		"rules_channel_id", "public_updates_channel_id", "vanity_url_code", "max_members", "emoji_limit",
+		"sticker_limit", "bitrate_limit", "preferred_locale",
+	}
+	for _, attrName := range computedAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsComputed(), "Attribute %s should be computed", attrName)
+	}
 }
 
 func TestServerDataSource_Configure(t *testing.T) {
@@ -53,8 +69,8 @@ func TestServerDataSource_Configure(t *testing.T) {
 		errorContains string
 	}{
 		{
-			name:         "valid discordgo.Session",
-			providerData: &discordgo.Session{},
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
 			expectError:  false,
 		},
 		{
@@ -92,6 +108,104 @@ func TestServerDataSource_Configure(t *testing.T) {
 	}
 }
 
+func TestGuildLimitsFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		guild    *discordgo.Guild
+		expected guildLimits
+	}{
+		{
+			name:     "no boosts",
+			guild:    &discordgo.Guild{PremiumTier: discordgo.PremiumTierNone},
+			expected: guildLimits{emojis: 50, stickers: 5, bitrate: 96000},
+		},
+		{
+			name:     "tier 3",
+			guild:    &discordgo.Guild{PremiumTier: discordgo.PremiumTier3},
+			expected: guildLimits{emojis: 250, stickers: 60, bitrate: 384000},
+		},
+		{
+			name: "features raise the limits of a low tier",
+			guild: &discordgo.Guild{
+				PremiumTier: discordgo.PremiumTier1,
+				Features:    []discordgo.GuildFeature{"MORE_EMOJI", discordgo.GuildFeatureMoreStickers, discordgo.GuildFeatureVipRegions},
+			},
+			expected: guildLimits{emojis: 200, stickers: 60, bitrate: 384000},
+		},
+		{
+			name: "features never lower the limits of a high tier",
+			guild: &discordgo.Guild{
+				PremiumTier: discordgo.PremiumTier3,
+				Features:    []discordgo.GuildFeature{"MORE_EMOJI"},
+			},
+			expected: guildLimits{emojis: 250, stickers: 60, bitrate: 384000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, guildLimitsFor(tt.guild))
+		})
+	}
+}
+
+func TestAccServerDataSource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild", discordgo.GuildFeatureCommunity)
+	rules := s.AddChannel(guild.ID, "rules", discordgo.ChannelTypeGuildText, "")
+	s.EditGuild(guild.ID, func(g *discordgo.Guild) {
+		g.PremiumTier = discordgo.PremiumTier2
+		g.PremiumSubscriptionCount = 7
+		g.VerificationLevel = discordgo.VerificationLevelMedium
+		g.RulesChannelID = rules.ID
+		g.MaxMembers = 500000
+	})
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "discord_server" "test" {
+  server_id = %q
+}
+`, guild.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "name", "Test Guild"),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "owner_id", guild.OwnerID),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "approximate_member_count", "2"),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "approximate_presence_count", "1"),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "premium_tier", "2"),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "premium_subscription_count", "7"),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "verification_level", "2"),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "rules_channel_id", rules.ID),
+					tfresource.TestCheckNoResourceAttr("data.discord_server.test", "afk_channel_id"),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "max_members", "500000"),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "emoji_limit", "150"),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "sticker_limit", "30"),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "bitrate_limit", "256000"),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "preferred_locale", "en-US"),
+				),
+			},
+			{
+				// Without server_id, the provider's default guild is used
+				Config: testAccProviderConfigWithGuild(s, guild.ID) + `
+data "discord_server" "test" {}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "server_id", guild.ID),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "id", guild.ID),
+					tfresource.TestCheckResourceAttr("data.discord_server.test", "name", "Test Guild"),
+				),
+			},
+			{
+				Config:      providerConfig + `data "discord_server" "test" {}`,
+				ExpectError: regexp.MustCompile(`Missing Server ID`),
+			},
+		},
+	})
+}
+
 // Note: Tests for Read() method that require Discord API calls should be
 // implemented as acceptance tests with TF_ACC=1 environment variable set.
 // These unit tests verify the schema, metadata, and configuration validation
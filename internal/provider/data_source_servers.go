@@ -3,14 +3,19 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// userGuildsPageSize is the most guilds Discord returns per request.
+const userGuildsPageSize = 200
+
 // Ensure the data source type implements the required interfaces.
 var _ datasource.DataSource = &serversDataSource{}
 
@@ -21,17 +26,31 @@ type serversDataSource struct {
 
 // serversDataSourceModel describes the data source data model.
 type serversDataSourceModel struct {
-	Servers types.List `tfsdk:"servers"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+	Owner      types.Bool   `tfsdk:"owner"`
+	Features   types.List   `tfsdk:"features"`
+	WithCounts types.Bool   `tfsdk:"with_counts"`
+	Total      types.Int64  `tfsdk:"total"`
+	Servers    types.List   `tfsdk:"servers"`
+}
+
+// serversFilter holds the server filters of the data source.
+type serversFilter struct {
+	nameRegex *regexp.Regexp
+	owner     *bool
+	features  []string
 }
 
 // serverModel describes a single server (guild) in the data source.
 type serverModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Icon        types.String `tfsdk:"icon"`
-	Owner       types.Bool   `tfsdk:"owner"`
-	Permissions types.Int64  `tfsdk:"permissions"`
-	Features    types.List   `tfsdk:"features"`
+	ID                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Icon                     types.String `tfsdk:"icon"`
+	Owner                    types.Bool   `tfsdk:"owner"`
+	Permissions              types.Int64  `tfsdk:"permissions"`
+	Features                 types.List   `tfsdk:"features"`
+	ApproximateMemberCount   types.Int64  `tfsdk:"approximate_member_count"`
+	ApproximatePresenceCount types.Int64  `tfsdk:"approximate_presence_count"`
 }
 
 // NewServersDataSource is a helper function to simplify testing.
@@ -39,6 +58,57 @@ func NewServersDataSource() datasource.DataSource {
 	return &serversDataSource{}
 }
 
+// fetchAllUserGuilds pages through UserGuilds using the after cursor and returns every guild the bot is a member of.
+func fetchAllUserGuilds(ctx context.Context, client *discordgo.Session, withCounts bool) ([]*discordgo.UserGuild, error) {
+	var all []*discordgo.UserGuild
+	after := ""
+
+	for {
+		page, err := client.UserGuilds(userGuildsPageSize, "", after, withCounts, discordgo.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page...)
+
+		if len(page) < userGuildsPageSize {
+			return all, nil
+		}
+		after = page[len(page)-1].ID
+	}
+}
+
+// filterUserGuilds returns the guilds that match every configured filter.
+func filterUserGuilds(guilds []*discordgo.UserGuild, filter serversFilter) []*discordgo.UserGuild {
+	matched := make([]*discordgo.UserGuild, 0, len(guilds))
+	for _, guild := range guilds {
+		if filter.nameRegex != nil && !filter.nameRegex.MatchString(guild.Name) {
+			continue
+		}
+
+		if filter.owner != nil && guild.Owner != *filter.owner {
+			continue
+		}
+
+		held := 0
+		for _, want := range filter.features {
+			for _, feature := range guild.Features {
+				if string(feature) == want {
+					held++
+					break
+				}
+			}
+		}
+		if held < len(filter.features) {
+			continue
+		}
+
+		matched = append(matched, guild)
+	}
+
+	return matched
+}
+
 // Metadata returns the data source type name.
 func (d *serversDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_servers"
@@ -47,8 +117,30 @@ func (d *serversDataSource) Metadata(_ context.Context, req datasource.MetadataR
 // Schema defines the schema for the data source.
 func (d *serversDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Retrieves a list of Discord servers (guilds) that the bot is a member of.",
+		Description: "Retrieves a list of Discord servers (guilds) that the bot is a member of, optionally filtered by name, ownership or feature. " +
+			"Pages through the bot's guilds in batches of 200.",
 		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Only return servers whose name matches this regular expression (Go RE2 syntax).",
+				Optional:    true,
+			},
+			"owner": schema.BoolAttribute{
+				Description: "If true, only return servers the bot owns. If false, only return servers the bot does not own. If unset, return both.",
+				Optional:    true,
+			},
+			"features": schema.ListAttribute{
+				Description: "Only return servers that have every one of these features enabled (e.g. COMMUNITY).",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"with_counts": schema.BoolAttribute{
+				Description: "Whether to include approximate_member_count and approximate_presence_count for each server. Defaults to false.",
+				Optional:    true,
+			},
+			"total": schema.Int64Attribute{
+				Description: "The number of servers that matched the filters.",
+				Computed:    true,
+			},
 			"servers": schema.ListNestedAttribute{
 				Description: "List of servers (guilds) the bot is a member of.",
 				Computed:    true,
@@ -79,6 +171,14 @@ func (d *serversDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 							ElementType: types.StringType,
 							Computed:    true,
 						},
+						"approximate_member_count": schema.Int64Attribute{
+							Description: "The approximate number of members in the server (guild). Only set when with_counts is true.",
+							Computed:    true,
+						},
+						"approximate_presence_count": schema.Int64Attribute{
+							Description: "The approximate number of online members in the server (guild). Only set when with_counts is true.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -92,16 +192,16 @@ func (d *serversDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -123,19 +223,49 @@ func (d *serversDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	// Fetch all guilds (servers) the bot is a member of
-	// Using limit 200 (max) to get as many guilds as possible
-	// Empty strings for beforeID and afterID to get all guilds
-	// withCounts = false to avoid extra API calls
-	guilds, err := d.client.UserGuilds(200, "", "", false)
+	// Build the server filters
+	filter := serversFilter{}
+
+	if !data.NameRegex.IsNull() && !data.NameRegex.IsUnknown() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				fmt.Sprintf("The name_regex attribute must be a valid regular expression: %s", err.Error()),
+			)
+			return
+		}
+		filter.nameRegex = nameRegex
+	}
+
+	if !data.Owner.IsNull() && !data.Owner.IsUnknown() {
+		owner := data.Owner.ValueBool()
+		filter.owner = &owner
+	}
+
+	if !data.Features.IsNull() && !data.Features.IsUnknown() {
+		resp.Diagnostics.Append(data.Features.ElementsAs(ctx, &filter.features, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	withCounts := data.WithCounts.ValueBool()
+
+	// Fetch every guild (server) the bot is a member of, 200 at a time
+	guilds, err := fetchAllUserGuilds(ctx, d.client, withCounts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Servers",
-			fmt.Sprintf("Unable to fetch servers: %s", err.Error()),
+			discordErrorDetail("Unable to fetch servers", err),
 		)
 		return
 	}
 
+	guilds = filterUserGuilds(guilds, filter)
+	data.Total = types.Int64Value(int64(len(guilds)))
+
 	// Convert Discord guilds to Terraform model
 	serverList := make([]serverModel, 0, len(guilds))
 	for _, guild := range guilds {
@@ -146,6 +276,15 @@ func (d *serversDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			Permissions: types.Int64Value(guild.Permissions),
 		}
 
+		// Counts are only returned when requested
+		if withCounts {
+			serverModel.ApproximateMemberCount = types.Int64Value(int64(guild.ApproximateMemberCount))
+			serverModel.ApproximatePresenceCount = types.Int64Value(int64(guild.ApproximatePresenceCount))
+		} else {
+			serverModel.ApproximateMemberCount = types.Int64Null()
+			serverModel.ApproximatePresenceCount = types.Int64Null()
+		}
+
 		// Handle icon (can be empty string)
 		if guild.Icon != "" {
 			serverModel.Icon = types.StringValue(guild.Icon)
@@ -172,12 +311,14 @@ func (d *serversDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	// Convert to Terraform list
 	serverListValue, diags := types.ListValueFrom(ctx, types.ObjectType{
 		AttrTypes: map[string]attr.Type{
-			"id":          types.StringType,
-			"name":        types.StringType,
-			"icon":        types.StringType,
-			"owner":       types.BoolType,
-			"permissions": types.Int64Type,
-			"features":    types.ListType{ElemType: types.StringType},
+			"id":                         types.StringType,
+			"name":                       types.StringType,
+			"icon":                       types.StringType,
+			"owner":                      types.BoolType,
+			"permissions":                types.Int64Type,
+			"features":                   types.ListType{ElemType: types.StringType},
+			"approximate_member_count":   types.Int64Type,
+			"approximate_presence_count": types.Int64Type,
 		},
 	}, serverList)
 	resp.Diagnostics.Append(diags...)
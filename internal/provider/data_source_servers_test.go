@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -30,10 +33,21 @@ func TestServersDataSource_Schema(t *testing.T) {
 	assert.NotNil(t, resp.Schema)
 	assert.Contains(t, resp.Schema.Description, "Retrieves a list of Discord servers")
 
-	// Check computed attribute
-	serversAttr, ok := resp.Schema.Attributes["servers"]
-	assert.True(t, ok)
-	assert.True(t, serversAttr.IsComputed())
+	// Check optional filter attributes
+	optionalAttrs := []string{"name_regex", "owner", "features", "with_counts"}
+	for _, attrName := range optionalAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsOptional(), "Attribute %s should be optional", attrName)
+	}
+
+	// Check computed attributes
+	computedAttrs := []string{"total", "servers"}
+	for _, attrName := range computedAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsComputed(), "Attribute %s should be computed", attrName)
+	}
 }
 
 func TestServersDataSource_Configure(t *testing.T) {
@@ -44,8 +58,8 @@ func TestServersDataSource_Configure(t *testing.T) {
 		errorContains string
 	}{
 		{
-			name:         "valid discordgo.Session",
-			providerData: &discordgo.Session{},
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
 			expectError:  false,
 		},
 		{
@@ -83,6 +97,81 @@ func TestServersDataSource_Configure(t *testing.T) {
 	}
 }
 
+func TestFilterUserGuilds(t *testing.T) {
+	guilds := []*discordgo.UserGuild{
+		{ID: "1", Name: "prod-eu", Owner: true, Features: []discordgo.GuildFeature{discordgo.GuildFeatureCommunity}},
+		{ID: "2", Name: "prod-us", Features: []discordgo.GuildFeature{discordgo.GuildFeatureCommunity, discordgo.GuildFeatureNews}},
+		{ID: "3", Name: "staging", Owner: true, Features: []discordgo.GuildFeature{}},
+	}
+
+	ids := func(guilds []*discordgo.UserGuild) []string {
+		out := make([]string, 0, len(guilds))
+		for _, g := range guilds {
+			out = append(out, g.ID)
+		}
+		return out
+	}
+
+	owned := true
+
+	assert.Equal(t, []string{"1", "2", "3"}, ids(filterUserGuilds(guilds, serversFilter{})))
+	assert.Equal(t, []string{"1", "2"}, ids(filterUserGuilds(guilds, serversFilter{nameRegex: regexp.MustCompile(`^prod-`)})))
+	assert.Equal(t, []string{"1", "3"}, ids(filterUserGuilds(guilds, serversFilter{owner: &owned})))
+	assert.Equal(t, []string{"1", "2"}, ids(filterUserGuilds(guilds, serversFilter{features: []string{"COMMUNITY"}})))
+	assert.Equal(t, []string{"2"}, ids(filterUserGuilds(guilds, serversFilter{features: []string{"COMMUNITY", "NEWS"}})))
+	assert.Equal(t, []string{"1"}, ids(filterUserGuilds(guilds, serversFilter{owner: &owned, features: []string{"COMMUNITY"}})))
+}
+
+func TestAccServersDataSource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+
+	// More guilds than fit in one page, so the data source has to follow the after cursor
+	for i := 0; i < userGuildsPageSize+5; i++ {
+		s.AddGuild(fmt.Sprintf("fleet-%03d", i))
+	}
+	community := s.AddGuild("community", discordgo.GuildFeatureCommunity)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + `
+data "discord_servers" "all" {}
+
+data "discord_servers" "fleet" {
+  name_regex  = "^fleet-00[0-4]$"
+  with_counts = true
+}
+
+data "discord_servers" "community" {
+  features = ["COMMUNITY"]
+  owner    = false
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_servers.all", "total", fmt.Sprint(userGuildsPageSize+6)),
+					tfresource.TestCheckResourceAttr("data.discord_servers.all", "servers.#", fmt.Sprint(userGuildsPageSize+6)),
+					tfresource.TestCheckNoResourceAttr("data.discord_servers.all", "servers.0.approximate_member_count"),
+					tfresource.TestCheckResourceAttr("data.discord_servers.fleet", "total", "5"),
+					tfresource.TestCheckResourceAttr("data.discord_servers.fleet", "servers.0.name", "fleet-000"),
+					tfresource.TestCheckResourceAttr("data.discord_servers.fleet", "servers.0.approximate_member_count", "2"),
+					tfresource.TestCheckResourceAttr("data.discord_servers.fleet", "servers.0.approximate_presence_count", "1"),
+					tfresource.TestCheckResourceAttr("data.discord_servers.community", "total", "1"),
+					tfresource.TestCheckResourceAttr("data.discord_servers.community", "servers.0.id", community.ID),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "discord_servers" "test" {
+  name_regex = "fleet-("
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Name Regex`),
+			},
+		},
+	})
+}
+
 // Note: Tests for Read() method that require Discord API calls should be
 // implemented as acceptance tests with TF_ACC=1 environment variable set.
 // These unit tests verify the schema, metadata, and configuration validation
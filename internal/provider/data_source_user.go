@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source type implements the required interfaces.
+var _ datasource.DataSource = &userDataSource{}
+
+// userDataSource defines the data source implementation.
+type userDataSource struct {
+	client *discordgo.Session
+}
+
+// userDataSourceModel describes the data source data model.
+type userDataSourceModel struct {
+	UserID        types.String `tfsdk:"user_id"`
+	ID            types.String `tfsdk:"id"`
+	Username      types.String `tfsdk:"username"`
+	GlobalName    types.String `tfsdk:"global_name"`
+	Discriminator types.String `tfsdk:"discriminator"`
+	Avatar        types.String `tfsdk:"avatar"`
+	AvatarURL     types.String `tfsdk:"avatar_url"`
+	Banner        types.String `tfsdk:"banner"`
+	BannerURL     types.String `tfsdk:"banner_url"`
+	AccentColor   types.Int64  `tfsdk:"accent_color"`
+	PublicFlags   types.Int64  `tfsdk:"public_flags"`
+	Bot           types.Bool   `tfsdk:"bot"`
+	System        types.Bool   `tfsdk:"system"`
+}
+
+// NewUserDataSource is a helper function to simplify testing.
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the data source.
+func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves any Discord user by ID, whether or not they are a member of a guild the bot is in. " +
+			"Use discord_member for guild-specific details such as nickname and roles.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user to retrieve.",
+				Required:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the user (same as user_id).",
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the user.",
+				Computed:    true,
+			},
+			"global_name": schema.StringAttribute{
+				Description: "The display name of the user, or null if it has none.",
+				Computed:    true,
+			},
+			"discriminator": schema.StringAttribute{
+				Description: "The discriminator of the user. \"0\" for users on the unique username system.",
+				Computed:    true,
+			},
+			"avatar": schema.StringAttribute{
+				Description: "The avatar hash of the user, or null if it has none.",
+				Computed:    true,
+			},
+			"avatar_url": schema.StringAttribute{
+				Description: "The CDN URL of the user's avatar, or of their default avatar if they have none.",
+				Computed:    true,
+			},
+			"banner": schema.StringAttribute{
+				Description: "The banner hash of the user, or null if it has none.",
+				Computed:    true,
+			},
+			"banner_url": schema.StringAttribute{
+				Description: "The CDN URL of the user's banner, or null if they have none.",
+				Computed:    true,
+			},
+			"accent_color": schema.Int64Attribute{
+				Description: "The user's banner color (as an integer), or null if it is not set.",
+				Computed:    true,
+			},
+			"public_flags": schema.Int64Attribute{
+				Description: "The public flags on the user's account, such as the verified bot flag (65536).",
+				Computed:    true,
+			},
+			"bot": schema.BoolAttribute{
+				Description: "Whether the user is a bot.",
+				Computed:    true,
+			},
+			"system": schema.BoolAttribute{
+				Description: "Whether the user is an official Discord system user.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure sets up the data source with the provider's configured client.
+func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure client is configured
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	userID := data.UserID.ValueString()
+	user, err := fetchUser(ctx, d.client, userID)
+	if err != nil {
+		if isDiscordNotFound(err) {
+			resp.Diagnostics.AddError(
+				"User Not Found",
+				fmt.Sprintf("User %s was not found.", userID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Fetching User",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch user %s", userID), err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(user.ID)
+	data.Username = types.StringValue(user.Username)
+	data.GlobalName = stringValueOrNull(user.GlobalName)
+	data.Discriminator = types.StringValue(user.Discriminator)
+	data.Avatar = stringValueOrNull(user.Avatar)
+	data.AvatarURL = types.StringValue(user.AvatarURL(""))
+	data.Banner = stringValueOrNull(user.Banner)
+	data.BannerURL = stringValueOrNull(user.BannerURL(""))
+	data.PublicFlags = types.Int64Value(int64(user.PublicFlags))
+	data.Bot = types.BoolValue(user.Bot)
+	data.System = types.BoolValue(user.System)
+
+	data.AccentColor = types.Int64Null()
+	if user.AccentColor != nil {
+		data.AccentColor = types.Int64Value(int64(*user.AccentColor))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// discordUser is a user as the Discord API returns it. discordgo decodes a null accent_color as 0, which is
+// also black, so the field is decoded as a pointer here.
+type discordUser struct {
+	discordgo.User
+	AccentColor *int `json:"accent_color"`
+}
+
+// fetchUser fetches a user by ID.
+func fetchUser(ctx context.Context, client *discordgo.Session, userID string) (*discordUser, error) {
+	endpoint := discordgo.EndpointUser(userID)
+	body, err := client.RequestWithBucketID("GET", endpoint, nil, discordgo.EndpointUsers, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var user discordUser
+	if err := json.Unmarshal(body, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
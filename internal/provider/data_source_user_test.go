@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserDataSource_Metadata(t *testing.T) {
+	d := NewUserDataSource()
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	d.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_user", resp.TypeName)
+}
+
+func TestUserDataSource_Schema(t *testing.T) {
+	d := NewUserDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	d.Schema(t.Context(), req, resp)
+
+	assert.NotNil(t, resp.Schema)
+
+	userIDAttr, ok := resp.Schema.Attributes["user_id"]
+	assert.True(t, ok)
+	assert.True(t, userIDAttr.IsRequired())
+
+	computedAttrs := []string{"id", "username", "global_name", "avatar", "avatar_url", "banner", "banner_url", "accent_color", "public_flags", "bot", "system"}
+	for _, attrName := range computedAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsComputed(), "Attribute %s should be computed", attrName)
+	}
+}
+
+func TestUserDataSource_Configure(t *testing.T) {
+	tests := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
+			expectError:  false,
+		},
+		{
+			name:          "invalid provider data type",
+			providerData:  "invalid",
+			expectError:   true,
+			errorContains: "Unexpected Data Source Configure Type",
+		},
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &userDataSource{}
+			req := datasource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			d.Configure(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+func TestAccUserDataSource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+
+	// Neither user is a member of any guild
+	spammer := s.AddUser("spammer")
+	s.EditUser(spammer.ID, func(user *discordgo.User) {
+		user.GlobalName = "Totally Legit"
+		user.Avatar = "a_1f2e3d"
+		user.Banner = "4c5b6a"
+		user.PublicFlags = discordgo.UserFlagHouseBravery
+	})
+	s.SetUserAccentColor(spammer.ID, 0x5865F2)
+	newcomer := s.AddUser("newcomer")
+	// Black is a real accent color, not an unset one
+	goth := s.AddUser("goth")
+	s.SetUserAccentColor(goth.ID, 0)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "discord_user" "spammer" {
+  user_id = %q
+}
+
+data "discord_user" "newcomer" {
+  user_id = %q
+}
+
+data "discord_user" "goth" {
+  user_id = %q
+}
+`, spammer.ID, newcomer.ID, goth.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_user.spammer", "id", spammer.ID),
+					tfresource.TestCheckResourceAttr("data.discord_user.spammer", "username", "spammer"),
+					tfresource.TestCheckResourceAttr("data.discord_user.spammer", "global_name", "Totally Legit"),
+					tfresource.TestCheckResourceAttr("data.discord_user.spammer", "avatar", "a_1f2e3d"),
+					tfresource.TestCheckResourceAttr("data.discord_user.spammer", "avatar_url", discordgo.EndpointUserAvatarAnimated(spammer.ID, "a_1f2e3d")),
+					tfresource.TestCheckResourceAttr("data.discord_user.spammer", "banner", "4c5b6a"),
+					tfresource.TestCheckResourceAttr("data.discord_user.spammer", "banner_url", discordgo.EndpointUserBanner(spammer.ID, "4c5b6a")),
+					tfresource.TestCheckResourceAttr("data.discord_user.spammer", "accent_color", fmt.Sprint(0x5865F2)),
+					tfresource.TestCheckResourceAttr("data.discord_user.spammer", "public_flags", fmt.Sprint(int(discordgo.UserFlagHouseBravery))),
+					tfresource.TestCheckResourceAttr("data.discord_user.spammer", "bot", "false"),
+					tfresource.TestCheckNoResourceAttr("data.discord_user.newcomer", "global_name"),
+					tfresource.TestCheckNoResourceAttr("data.discord_user.newcomer", "avatar"),
+					tfresource.TestCheckResourceAttr("data.discord_user.newcomer", "avatar_url", discordgo.EndpointDefaultUserAvatar(0)),
+					tfresource.TestCheckNoResourceAttr("data.discord_user.newcomer", "banner_url"),
+					tfresource.TestCheckNoResourceAttr("data.discord_user.newcomer", "accent_color"),
+					tfresource.TestCheckResourceAttr("data.discord_user.goth", "accent_color", "0"),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "discord_user" "test" {
+  user_id = "999999999999999999"
+}
+`,
+				ExpectError: regexp.MustCompile(`User Not Found`),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_invalidConfig(t *testing.T) {
+	_, providerConfig := testAccFakeDiscord(t)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + `
+data "discord_user" "test" {
+  user_id = "alice#1234"
+}
+`,
+				ExpectError: regexp.MustCompile(`must be a Discord ID`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source type implements the required interfaces.
+var _ datasource.DataSource = &webhookDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &webhookDataSource{}
+
+// webhookDataSource defines the data source implementation.
+type webhookDataSource struct {
+	client *discordgo.Session
+	// apiURL is the provider's API base URL, under which webhook URLs are built.
+	apiURL string
+}
+
+// webhookDataSourceModel describes the data source data model.
+type webhookDataSourceModel struct {
+	WebhookID         types.String `tfsdk:"webhook_id"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Type              types.Int64  `tfsdk:"type"`
+	ChannelID         types.String `tfsdk:"channel_id"`
+	GuildID           types.String `tfsdk:"guild_id"`
+	Avatar            types.String `tfsdk:"avatar"`
+	ApplicationID     types.String `tfsdk:"application_id"`
+	CreatorID         types.String `tfsdk:"creator_id"`
+	Token             types.String `tfsdk:"token"`
+	URL               types.String `tfsdk:"url"`
+	SourceGuildID     types.String `tfsdk:"source_guild_id"`
+	SourceGuildName   types.String `tfsdk:"source_guild_name"`
+	SourceChannelID   types.String `tfsdk:"source_channel_id"`
+	SourceChannelName types.String `tfsdk:"source_channel_name"`
+}
+
+// NewWebhookDataSource is a helper function to simplify testing.
+func NewWebhookDataSource() datasource.DataSource {
+	return &webhookDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *webhookDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+// Schema defines the schema for the data source.
+func (d *webhookDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := webhookComputedAttributes()
+	attributes["webhook_id"] = schema.StringAttribute{
+		Description: "The ID of the webhook to retrieve. Exactly one of webhook_id or name must be provided.",
+		Optional:    true,
+		Validators: []validator.String{
+			snowflakeValidator(),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "The name of the webhook to look up in channel_id. Exactly one of webhook_id or name must be provided. The lookup fails if more than one webhook matches.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.AlsoRequires(path.MatchRoot("channel_id")),
+		},
+	}
+	attributes["channel_id"] = schema.StringAttribute{
+		Description: "The ID of the channel the webhook posts to. Required with name.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("webhook_id")),
+			snowflakeValidator(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a single Discord webhook by ID, or by name within a channel. The token is only returned when the webhook was created by the provider's bot.",
+		Attributes:  attributes,
+	}
+}
+
+// ConfigValidators requires exactly one way of identifying the webhook.
+func (d *webhookDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("webhook_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Configure sets up the data source with the provider's configured client.
+func (d *webhookDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+	d.apiURL = providerData.apiURL
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *webhookDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webhookDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure client is configured
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	var webhook *discordWebhook
+
+	if webhookID := data.WebhookID.ValueString(); webhookID != "" {
+		// Lookup by ID
+		if err := fetchWebhookJSON(ctx, d.client, discordgo.EndpointWebhook(webhookID), &webhook); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Fetching Webhook",
+				discordErrorDetail(fmt.Sprintf("Unable to fetch webhook %s", webhookID), err),
+			)
+			return
+		}
+	} else {
+		// Lookup by name within the channel
+		channelID := data.ChannelID.ValueString()
+		var webhooks []*discordWebhook
+		if err := fetchWebhookJSON(ctx, d.client, discordgo.EndpointChannelWebhooks(channelID), &webhooks); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Fetching Webhooks",
+				discordErrorDetail(fmt.Sprintf("Unable to fetch webhooks from channel %s", channelID), err),
+			)
+			return
+		}
+
+		name := data.Name.ValueString()
+		var ids []string
+		for _, candidate := range webhooks {
+			if candidate.Name == name {
+				webhook = candidate
+				ids = append(ids, candidate.ID)
+			}
+		}
+		switch len(ids) {
+		case 0:
+			resp.Diagnostics.AddError(
+				"Webhook Not Found",
+				fmt.Sprintf("No webhook found with name '%s' in channel %s.", name, channelID),
+			)
+			return
+		case 1:
+		default:
+			resp.Diagnostics.AddError(
+				"Multiple Webhooks Found",
+				fmt.Sprintf("%d webhooks named '%s' were found in channel %s: %s. Use webhook_id instead.", len(ids), name, channelID, strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	bot, err := d.client.User("@me", discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Bot User",
+			discordErrorDetail("Unable to fetch the bot user", err),
+		)
+		return
+	}
+
+	// Populate the model with webhook data
+	model := newWebhookModel(webhook, bot.ID, d.apiURL)
+	data.ID = model.ID
+	data.Name = model.Name
+	data.Type = model.Type
+	data.ChannelID = model.ChannelID
+	data.GuildID = model.GuildID
+	data.Avatar = model.Avatar
+	data.ApplicationID = model.ApplicationID
+	data.CreatorID = model.CreatorID
+	data.Token = model.Token
+	data.URL = model.URL
+	data.SourceGuildID = model.SourceGuildID
+	data.SourceGuildName = model.SourceGuildName
+	data.SourceChannelID = model.SourceChannelID
+	data.SourceChannelName = model.SourceChannelName
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookDataSource_Metadata(t *testing.T) {
+	d := NewWebhookDataSource()
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	d.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_webhook", resp.TypeName)
+}
+
+func TestWebhookDataSource_Schema(t *testing.T) {
+	d := NewWebhookDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	d.Schema(t.Context(), req, resp)
+
+	assert.NotNil(t, resp.Schema)
+	assert.Contains(t, resp.Schema.Description, "webhook")
+
+	for _, attrName := range []string{"webhook_id", "name", "channel_id"} {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsOptional(), "Attribute %s should be optional", attrName)
+	}
+
+	tokenAttr, ok := resp.Schema.Attributes["token"]
+	assert.True(t, ok)
+	assert.True(t, tokenAttr.IsComputed())
+	assert.True(t, tokenAttr.IsSensitive())
+}
+
+func TestWebhookDataSource_Configure(t *testing.T) {
+	tests := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
+			expectError:  false,
+		},
+		{
+			name:          "invalid provider data type",
+			providerData:  "invalid",
+			expectError:   true,
+			errorContains: "Unexpected Data Source Configure Type",
+		},
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &webhookDataSource{}
+			req := datasource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			d.Configure(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+func TestAccWebhookDataSource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	general := s.AddChannel(guild.ID, "general", discordgo.ChannelTypeGuildText, "")
+	other := s.AddUser("other-bot")
+
+	deploys := s.AddWebhook(general.ID, discordgo.Webhook{Name: "deploys", User: s.BotUser})
+	integration := s.AddWebhook(general.ID, discordgo.Webhook{Name: "integration", User: other, ApplicationID: other.ID})
+	s.AddWebhook(general.ID, discordgo.Webhook{Name: "duplicate", User: s.BotUser})
+	s.AddWebhook(general.ID, discordgo.Webhook{Name: "duplicate", User: s.BotUser})
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "discord_webhook" "by_id" {
+  webhook_id = %[1]q
+}
+
+data "discord_webhook" "by_name" {
+  channel_id = %[2]q
+  name       = "integration"
+}
+`, deploys.ID, general.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_webhook.by_id", "name", "deploys"),
+					tfresource.TestCheckResourceAttr("data.discord_webhook.by_id", "channel_id", general.ID),
+					tfresource.TestCheckResourceAttr("data.discord_webhook.by_id", "guild_id", guild.ID),
+					tfresource.TestCheckResourceAttr("data.discord_webhook.by_id", "type", "1"),
+					tfresource.TestCheckResourceAttr("data.discord_webhook.by_id", "token", deploys.Token),
+					tfresource.TestCheckResourceAttr("data.discord_webhook.by_name", "id", integration.ID),
+					tfresource.TestCheckResourceAttr("data.discord_webhook.by_name", "creator_id", other.ID),
+					tfresource.TestCheckNoResourceAttr("data.discord_webhook.by_name", "token"),
+					tfresource.TestCheckNoResourceAttr("data.discord_webhook.by_name", "url"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "discord_webhook" "test" {
+  channel_id = %[1]q
+  name       = "duplicate"
+}
+`, general.ID),
+				ExpectError: regexp.MustCompile(`Multiple Webhooks Found`),
+			},
+		},
+	})
+}
+
+func TestAccWebhookDataSource_invalidConfig(t *testing.T) {
+	_, providerConfig := testAccFakeDiscord(t)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + `
+data "discord_webhook" "test" {
+  name = "deploys"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source type implements the required interfaces.
+var _ datasource.DataSource = &webhooksDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &webhooksDataSource{}
+
+// webhooksDataSource defines the data source implementation.
+type webhooksDataSource struct {
+	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when neither channel_id nor guild_id is set.
+	defaultGuildID types.String
+	// apiURL is the provider's API base URL, under which webhook URLs are built.
+	apiURL string
+}
+
+// webhooksDataSourceModel describes the data source data model.
+type webhooksDataSourceModel struct {
+	ChannelID types.String `tfsdk:"channel_id"`
+	GuildID   types.String `tfsdk:"guild_id"`
+	Webhooks  types.List   `tfsdk:"webhooks"`
+}
+
+// discordWebhook is a webhook as Discord returns it. discordgo.Webhook lacks the source guild and channel that
+// Discord includes for channel follower webhooks.
+type discordWebhook struct {
+	discordgo.Webhook
+	SourceGuild   *discordgo.Guild   `json:"source_guild"`
+	SourceChannel *discordgo.Channel `json:"source_channel"`
+}
+
+// webhookModel describes a single webhook in the webhook data sources.
+type webhookModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Type              types.Int64  `tfsdk:"type"`
+	ChannelID         types.String `tfsdk:"channel_id"`
+	GuildID           types.String `tfsdk:"guild_id"`
+	Avatar            types.String `tfsdk:"avatar"`
+	ApplicationID     types.String `tfsdk:"application_id"`
+	CreatorID         types.String `tfsdk:"creator_id"`
+	Token             types.String `tfsdk:"token"`
+	URL               types.String `tfsdk:"url"`
+	SourceGuildID     types.String `tfsdk:"source_guild_id"`
+	SourceGuildName   types.String `tfsdk:"source_guild_name"`
+	SourceChannelID   types.String `tfsdk:"source_channel_id"`
+	SourceChannelName types.String `tfsdk:"source_channel_name"`
+}
+
+// webhookAttributeTypes are the attribute types of a webhooks element.
+var webhookAttributeTypes = map[string]attr.Type{
+	"id":                  types.StringType,
+	"name":                types.StringType,
+	"type":                types.Int64Type,
+	"channel_id":          types.StringType,
+	"guild_id":            types.StringType,
+	"avatar":              types.StringType,
+	"application_id":      types.StringType,
+	"creator_id":          types.StringType,
+	"token":               types.StringType,
+	"url":                 types.StringType,
+	"source_guild_id":     types.StringType,
+	"source_guild_name":   types.StringType,
+	"source_channel_id":   types.StringType,
+	"source_channel_name": types.StringType,
+}
+
+// NewWebhooksDataSource is a helper function to simplify testing.
+func NewWebhooksDataSource() datasource.DataSource {
+	return &webhooksDataSource{}
+}
+
+// webhookComputedAttributes returns the computed attributes describing a webhook, shared by discord_webhooks and
+// discord_webhook.
+func webhookComputedAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the webhook.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the webhook.",
+			Computed:    true,
+		},
+		"type": schema.Int64Attribute{
+			Description: "The type of the webhook (1 = Incoming, 2 = Channel Follower, 3 = Application).",
+			Computed:    true,
+		},
+		"channel_id": schema.StringAttribute{
+			Description: "The ID of the channel the webhook posts to.",
+			Computed:    true,
+		},
+		"guild_id": schema.StringAttribute{
+			Description: "The ID of the guild (server) the webhook belongs to.",
+			Computed:    true,
+		},
+		"avatar": schema.StringAttribute{
+			Description: "The avatar hash of the webhook, or null if no avatar is set.",
+			Computed:    true,
+		},
+		"application_id": schema.StringAttribute{
+			Description: "The ID of the application that created the webhook, or null if it was not created by an application.",
+			Computed:    true,
+		},
+		"creator_id": schema.StringAttribute{
+			Description: "The ID of the user who created the webhook.",
+			Computed:    true,
+		},
+		"token": schema.StringAttribute{
+			Description: "The token of the webhook. Only set when the webhook was created by the provider's bot; null otherwise.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"url": schema.StringAttribute{
+			Description: "The full webhook URL ({api_url}/webhooks/{id}/{token}, by default https://discord.com/api/webhooks/{id}/{token}). Only set when token is.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"source_guild_id": schema.StringAttribute{
+			Description: "For channel follower webhooks, the ID of the guild of the followed channel.",
+			Computed:    true,
+		},
+		"source_guild_name": schema.StringAttribute{
+			Description: "For channel follower webhooks, the name of the guild of the followed channel.",
+			Computed:    true,
+		},
+		"source_channel_id": schema.StringAttribute{
+			Description: "For channel follower webhooks, the ID of the followed announcement channel.",
+			Computed:    true,
+		},
+		"source_channel_name": schema.StringAttribute{
+			Description: "For channel follower webhooks, the name of the followed announcement channel.",
+			Computed:    true,
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *webhooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks"
+}
+
+// Schema defines the schema for the data source.
+func (d *webhooksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the webhooks of a Discord channel, or of every channel in a guild. Tokens are only returned for webhooks created by the provider's bot.",
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the channel to list webhooks for. Conflicts with guild_id.",
+				Optional:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild (server) to list webhooks for. Conflicts with channel_id. Defaults to the provider's guild_id when channel_id is not set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"webhooks": schema.ListNestedAttribute{
+				Description: "The webhooks of the channel or guild.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: webhookComputedAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators prevents listing by channel and guild at the same time.
+func (d *webhooksDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("channel_id"),
+			path.MatchRoot("guild_id"),
+		),
+	}
+}
+
+// Configure sets up the data source with the provider's configured client.
+func (d *webhooksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+	d.defaultGuildID = providerData.guildID
+	d.apiURL = providerData.apiURL
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *webhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webhooksDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure client is configured
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	var endpoint, source string
+	if channelID := data.ChannelID.ValueString(); channelID != "" {
+		endpoint = discordgo.EndpointChannelWebhooks(channelID)
+		source = "channel " + channelID
+	} else {
+		data.GuildID = guildIDOrDefault(data.GuildID, d.defaultGuildID)
+		guildID := data.GuildID.ValueString()
+		if guildID == "" {
+			resp.Diagnostics.AddError(
+				"Missing Guild ID",
+				missingGuildIDDetail,
+			)
+			return
+		}
+		endpoint = discordgo.EndpointGuildWebhooks(guildID)
+		source = "guild " + guildID
+	}
+
+	var webhooks []*discordWebhook
+	if err := fetchWebhookJSON(ctx, d.client, endpoint, &webhooks); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Webhooks",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch webhooks from %s", source), err),
+		)
+		return
+	}
+
+	bot, err := d.client.User("@me", discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Bot User",
+			discordErrorDetail("Unable to fetch the bot user", err),
+		)
+		return
+	}
+
+	models := make([]webhookModel, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		models = append(models, newWebhookModel(webhook, bot.ID, d.apiURL))
+	}
+
+	webhooksValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: webhookAttributeTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Webhooks = webhooksValue
+
+	// When listing by channel, report the channel's guild if any webhook names it
+	if !data.ChannelID.IsNull() {
+		data.GuildID = types.StringNull()
+		if len(webhooks) > 0 {
+			data.GuildID = stringValueOrNull(webhooks[0].GuildID)
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fetchWebhookJSON GETs endpoint and decodes the response into v. discordgo's webhook methods decode into
+// discordgo.Webhook, which drops the source guild and channel of channel follower webhooks.
+func fetchWebhookJSON(ctx context.Context, client *discordgo.Session, endpoint string, v interface{}) error {
+	body, err := client.RequestWithBucketID("GET", endpoint, nil, endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+// newWebhookModel converts a webhook to its data source model. The token is only kept when botID created the
+// webhook, either directly or as its application, and its URL is built under apiURL.
+func newWebhookModel(webhook *discordWebhook, botID, apiURL string) webhookModel {
+	model := webhookModel{
+		ID:                types.StringValue(webhook.ID),
+		Name:              types.StringValue(webhook.Name),
+		Type:              types.Int64Value(int64(webhook.Type)),
+		ChannelID:         types.StringValue(webhook.ChannelID),
+		GuildID:           stringValueOrNull(webhook.GuildID),
+		Avatar:            stringValueOrNull(webhook.Avatar),
+		ApplicationID:     stringValueOrNull(webhook.ApplicationID),
+		CreatorID:         types.StringNull(),
+		Token:             types.StringNull(),
+		URL:               types.StringNull(),
+		SourceGuildID:     types.StringNull(),
+		SourceGuildName:   types.StringNull(),
+		SourceChannelID:   types.StringNull(),
+		SourceChannelName: types.StringNull(),
+	}
+
+	if webhook.User != nil {
+		model.CreatorID = types.StringValue(webhook.User.ID)
+	}
+
+	if webhook.Token != "" && webhookOwnedBy(webhook, botID) {
+		model.Token = types.StringValue(webhook.Token)
+		model.URL = types.StringValue(webhookURL(apiURL, webhook.ID, webhook.Token))
+	}
+
+	if webhook.SourceGuild != nil {
+		model.SourceGuildID = types.StringValue(webhook.SourceGuild.ID)
+		model.SourceGuildName = types.StringValue(webhook.SourceGuild.Name)
+	}
+	if webhook.SourceChannel != nil {
+		model.SourceChannelID = types.StringValue(webhook.SourceChannel.ID)
+		model.SourceChannelName = types.StringValue(webhook.SourceChannel.Name)
+	}
+
+	return model
+}
+
+// webhookURL returns the URL that executes a webhook. It is built under the provider's API base URL rather
+// than discord.com, so a provider pointed at a proxy or a test server returns URLs that reach the same API.
+func webhookURL(apiURL, id, token string) string {
+	return strings.TrimSuffix(apiURL, "/") + "/webhooks/" + id + "/" + token
+}
+
+// webhookOwnedBy reports whether botID created the webhook, either as its user or as its application.
+func webhookOwnedBy(webhook *discordWebhook, botID string) bool {
+	if botID == "" {
+		return false
+	}
+	if webhook.User != nil && webhook.User.ID == botID {
+		return true
+	}
+	return webhook.ApplicationID == botID
+}
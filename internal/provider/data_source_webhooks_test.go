@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhooksDataSource_Metadata(t *testing.T) {
+	d := NewWebhooksDataSource()
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	d.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_webhooks", resp.TypeName)
+}
+
+func TestWebhooksDataSource_Schema(t *testing.T) {
+	d := NewWebhooksDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	d.Schema(t.Context(), req, resp)
+
+	assert.NotNil(t, resp.Schema)
+	assert.Contains(t, resp.Schema.Description, "webhooks")
+
+	for _, attrName := range []string{"channel_id", "guild_id"} {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsOptional(), "Attribute %s should be optional", attrName)
+	}
+
+	webhooksAttr, ok := resp.Schema.Attributes["webhooks"]
+	assert.True(t, ok)
+	assert.True(t, webhooksAttr.IsComputed())
+}
+
+func TestWebhooksDataSource_Configure(t *testing.T) {
+	tests := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
+			expectError:  false,
+		},
+		{
+			name:          "invalid provider data type",
+			providerData:  "invalid",
+			expectError:   true,
+			errorContains: "Unexpected Data Source Configure Type",
+		},
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &webhooksDataSource{}
+			req := datasource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			d.Configure(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+func TestNewWebhookModel(t *testing.T) {
+	bot := &discordgo.User{ID: "100"}
+	other := &discordgo.User{ID: "200"}
+
+	owned := newWebhookModel(&discordWebhook{Webhook: discordgo.Webhook{ID: "1", Token: "secret", User: bot}}, bot.ID, defaultAPIURL)
+	assert.Equal(t, types.StringValue("secret"), owned.Token)
+	assert.Equal(t, types.StringValue("https://discord.com/api/webhooks/1/secret"), owned.URL)
+	assert.True(t, owned.ApplicationID.IsNull())
+
+	proxied := newWebhookModel(&discordWebhook{Webhook: discordgo.Webhook{ID: "1", Token: "secret", User: bot}}, bot.ID, "https://discord-proxy.example.com/api/")
+	assert.Equal(t, types.StringValue("https://discord-proxy.example.com/api/webhooks/1/secret"), proxied.URL)
+
+	byApplication := newWebhookModel(&discordWebhook{Webhook: discordgo.Webhook{ID: "2", Token: "secret", User: other, ApplicationID: bot.ID}}, bot.ID, defaultAPIURL)
+	assert.Equal(t, types.StringValue("secret"), byApplication.Token)
+
+	foreign := newWebhookModel(&discordWebhook{Webhook: discordgo.Webhook{ID: "3", Token: "secret", User: other, ApplicationID: other.ID}}, bot.ID, defaultAPIURL)
+	assert.True(t, foreign.Token.IsNull())
+	assert.True(t, foreign.URL.IsNull())
+	assert.Equal(t, types.StringValue(other.ID), foreign.CreatorID)
+
+	follower := newWebhookModel(&discordWebhook{
+		Webhook:       discordgo.Webhook{ID: "4", Type: discordgo.WebhookTypeChannelFollower, User: bot},
+		SourceGuild:   &discordgo.Guild{ID: "5", Name: "Upstream"},
+		SourceChannel: &discordgo.Channel{ID: "6", Name: "announcements"},
+	}, bot.ID, defaultAPIURL)
+	assert.True(t, follower.Token.IsNull())
+	assert.Equal(t, types.Int64Value(2), follower.Type)
+	assert.Equal(t, types.StringValue("5"), follower.SourceGuildID)
+	assert.Equal(t, types.StringValue("Upstream"), follower.SourceGuildName)
+	assert.Equal(t, types.StringValue("6"), follower.SourceChannelID)
+	assert.Equal(t, types.StringValue("announcements"), follower.SourceChannelName)
+}
+
+func TestAccWebhooksDataSource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	general := s.AddChannel(guild.ID, "general", discordgo.ChannelTypeGuildText, "")
+	alerts := s.AddChannel(guild.ID, "alerts", discordgo.ChannelTypeGuildText, "")
+	announcements := s.AddChannel(guild.ID, "announcements", discordgo.ChannelTypeGuildNews, "")
+	other := s.AddUser("other-bot")
+
+	s.AddWebhook(general.ID, discordgo.Webhook{Name: "deploys", User: s.BotUser})
+	s.AddWebhook(general.ID, discordgo.Webhook{Name: "integration", User: other, ApplicationID: other.ID})
+	s.AddFollowerWebhook(alerts.ID, announcements.ID, "Test Guild #announcements")
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "discord_webhooks" "guild" {
+  guild_id = %[1]q
+}
+
+data "discord_webhooks" "general" {
+  channel_id = %[2]q
+}
+
+data "discord_webhooks" "alerts" {
+  channel_id = %[3]q
+}
+`, guild.ID, general.ID, alerts.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.discord_webhooks.guild", "webhooks.#", "3"),
+					tfresource.TestCheckResourceAttr("data.discord_webhooks.general", "webhooks.#", "2"),
+					tfresource.TestCheckResourceAttr("data.discord_webhooks.general", "guild_id", guild.ID),
+					tfresource.TestCheckResourceAttr("data.discord_webhooks.general", "webhooks.0.name", "deploys"),
+					tfresource.TestCheckResourceAttr("data.discord_webhooks.general", "webhooks.0.creator_id", s.BotUser.ID),
+					tfresource.TestCheckResourceAttrSet("data.discord_webhooks.general", "webhooks.0.token"),
+					tfresource.TestCheckResourceAttrWith("data.discord_webhooks.general", "webhooks.0.url", testAccCheckWebhookURL(s)),
+					tfresource.TestCheckResourceAttr("data.discord_webhooks.general", "webhooks.1.name", "integration"),
+					tfresource.TestCheckResourceAttr("data.discord_webhooks.general", "webhooks.1.application_id", other.ID),
+					tfresource.TestCheckNoResourceAttr("data.discord_webhooks.general", "webhooks.1.token"),
+					tfresource.TestCheckResourceAttr("data.discord_webhooks.alerts", "webhooks.0.type", "2"),
+					tfresource.TestCheckResourceAttr("data.discord_webhooks.alerts", "webhooks.0.source_guild_id", guild.ID),
+					tfresource.TestCheckResourceAttr("data.discord_webhooks.alerts", "webhooks.0.source_guild_name", "Test Guild"),
+					tfresource.TestCheckResourceAttr("data.discord_webhooks.alerts", "webhooks.0.source_channel_id", announcements.ID),
+					tfresource.TestCheckResourceAttr("data.discord_webhooks.alerts", "webhooks.0.source_channel_name", "announcements"),
+				),
+			},
+		},
+	})
+}
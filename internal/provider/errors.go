@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// discordErrorKind classifies an error returned by the Discord API.
+type discordErrorKind int
+
+const (
+	// discordErrorOther is any error without more specific handling.
+	discordErrorOther discordErrorKind = iota
+	// discordErrorUnknownResource means the channel, role, message, emoji, etc. no longer exists.
+	discordErrorUnknownResource
+	// discordErrorMissingAccess means the bot cannot see the guild or channel.
+	discordErrorMissingAccess
+	// discordErrorMissingPermissions means the bot lacks a permission or its role is too low.
+	discordErrorMissingPermissions
+	// discordErrorMissingIntent means the request needs a privileged intent that is not enabled.
+	discordErrorMissingIntent
+	// discordErrorRateLimited means the request was rejected by a rate limit.
+	discordErrorRateLimited
+	// discordErrorCommunityOnly means the feature is only available in Community guilds.
+	discordErrorCommunityOnly
+	// discordErrorUnauthorized means the token is invalid.
+	discordErrorUnauthorized
+	// discordErrorTimeout means the request did not finish before the operation or request timeout.
+	discordErrorTimeout
+)
+
+// unknownResourceCodes are the JSON error codes Discord returns when the requested object does not exist.
+var unknownResourceCodes = map[int]struct{}{
+	discordgo.ErrCodeUnknownChannel: {},
+	discordgo.ErrCodeUnknownGuild:   {},
+	discordgo.ErrCodeUnknownInvite:  {},
+	discordgo.ErrCodeUnknownMember:  {},
+	discordgo.ErrCodeUnknownMessage: {},
+	discordgo.ErrCodeUnknownRole:    {},
+	discordgo.ErrCodeUnknownUser:    {},
+	discordgo.ErrCodeUnknownEmoji:   {},
+	discordgo.ErrCodeUnknownWebhook: {},
+}
+
+// communityOnlyCodes are the JSON error codes Discord returns for features that need the COMMUNITY guild feature.
+var communityOnlyCodes = map[int]struct{}{
+	discordgo.ErrCodeCannotDeleteAChannelRequiredForCommunityGuilds: {},
+	discordgo.ErrCodeCommunityServerChannelsMustBeTextChannels:      {},
+}
+
+// classifyDiscordError returns the kind of a Discord API error. Errors that are not from the REST API are
+// discordErrorOther.
+func classifyDiscordError(err error) discordErrorKind {
+	if err == nil {
+		return discordErrorOther
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return discordErrorTimeout
+	}
+
+	var rateLimitErr *discordgo.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return discordErrorRateLimited
+	}
+
+	var restErr *discordgo.RESTError
+	if !errors.As(err, &restErr) {
+		return discordErrorOther
+	}
+
+	if restErr.Message != nil {
+		code := restErr.Message.Code
+		if _, ok := unknownResourceCodes[code]; ok {
+			return discordErrorUnknownResource
+		}
+		if _, ok := communityOnlyCodes[code]; ok {
+			return discordErrorCommunityOnly
+		}
+		switch code {
+		case discordgo.ErrCodeMissingAccess:
+			return discordErrorMissingAccess
+		case discordgo.ErrCodeMissingPermissions:
+			return discordErrorMissingPermissions
+		}
+	}
+
+	// A 404 without one of the unknown object codes is not treated as a deleted resource. It usually means the
+	// request never reached the Discord API, for example because api_url or http_proxy is wrong, and reading it
+	// as "deleted" would drop every resource from state.
+	if restErr.Response != nil {
+		switch restErr.Response.StatusCode {
+		case http.StatusUnauthorized:
+			return discordErrorUnauthorized
+		case http.StatusTooManyRequests:
+			return discordErrorRateLimited
+		case http.StatusForbidden:
+			return discordErrorMissingPermissions
+		}
+	}
+
+	return discordErrorOther
+}
+
+// isDiscordNotFound reports whether err means the requested Discord object does not exist.
+func isDiscordNotFound(err error) bool {
+	return classifyDiscordError(err) == discordErrorUnknownResource
+}
+
+// classifyMembersError classifies an error from a request that lists or fetches guild members. Discord answers
+// these with Missing Access when the GUILD_MEMBERS privileged intent is disabled.
+func classifyMembersError(err error) discordErrorKind {
+	kind := classifyDiscordError(err)
+	if kind == discordErrorMissingAccess {
+		return discordErrorMissingIntent
+	}
+	return kind
+}
+
+// discordErrorRemediation returns the remediation text for an error kind, or "" if there is none.
+func discordErrorRemediation(kind discordErrorKind) string {
+	switch kind {
+	case discordErrorUnknownResource:
+		return "The object no longer exists in Discord. It may have been deleted outside of Terraform."
+	case discordErrorMissingAccess:
+		return "The bot cannot access this guild or channel. Check that the bot is a member of the guild " +
+			"and has the 'View Channel' permission on the channel."
+	case discordErrorMissingPermissions:
+		return "The bot is missing a permission required for this request. Check that the bot's role has the " +
+			"permission listed in the provider documentation, and that the bot's highest role is above any role it manages."
+	case discordErrorMissingIntent:
+		return "This error typically indicates that the bot is missing the GUILD_MEMBERS privileged intent." +
+			"\n\nTo fix this:" +
+			"\n1. Go to https://discord.com/developers/applications" +
+			"\n2. Select your bot application" +
+			"\n3. Go to the 'Bot' section" +
+			"\n4. Scroll down to 'Privileged Gateway Intents'" +
+			"\n5. Enable 'SERVER MEMBERS INTENT' (GUILD_MEMBERS)" +
+			"\n6. Save changes" +
+			"\n7. Restart your bot/application" +
+			"\n\nNote: This is a Discord API requirement, not just a permission issue. Even with Administrator permissions," +
+			" the privileged intent must be enabled in the Developer Portal."
+	case discordErrorRateLimited:
+		return "Discord rate limited the request. Wait a moment and run Terraform again, or reduce parallelism with -parallelism=1."
+	case discordErrorCommunityOnly:
+		return "This feature is only available in Community servers. Enable Community in Server Settings → Enable Community first."
+	case discordErrorUnauthorized:
+		return "The bot token is invalid or has been reset. Copy a new token from the 'Bot' section of your application " +
+			"at https://discord.com/developers/applications and set it in the provider configuration or DISCORD_BOT_TOKEN."
+	case discordErrorTimeout:
+		return "The request did not finish in time. Increase the timeout for this operation in the resource's timeouts " +
+			"block, for example timeouts { create = \"30m\" }, or the provider's request_timeout if single requests are slow."
+	}
+	return ""
+}
+
+// discordErrorDetailForKind formats a diagnostic detail from a message, the error and the remediation for kind.
+func discordErrorDetailForKind(message string, err error, kind discordErrorKind) string {
+	detail := message + ": " + err.Error()
+	if remediation := discordErrorRemediation(kind); remediation != "" {
+		detail += "\n\n" + remediation
+	}
+	return detail
+}
+
+// discordErrorDetail formats a diagnostic detail for a failed Discord API request, adding remediation text
+// for the error's kind.
+func discordErrorDetail(message string, err error) string {
+	return discordErrorDetailForKind(message, err, classifyDiscordError(err))
+}
+
+// discordMembersErrorDetail is discordErrorDetail for requests that need the GUILD_MEMBERS intent.
+func discordMembersErrorDetail(message string, err error) string {
+	return discordErrorDetailForKind(message, err, classifyMembersError(err))
+}
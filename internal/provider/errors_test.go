@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRESTError(status, code int, body string) *discordgo.RESTError {
+	restErr := &discordgo.RESTError{
+		Response:     &http.Response{StatusCode: status, Status: fmt.Sprintf("%d %s", status, http.StatusText(status))},
+		ResponseBody: []byte(body),
+	}
+	if code != 0 {
+		restErr.Message = &discordgo.APIErrorMessage{Code: code, Message: body}
+	}
+	return restErr
+}
+
+func TestClassifyDiscordError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want discordErrorKind
+	}{
+		{"nil", nil, discordErrorOther},
+		{"plain error", errors.New("boom"), discordErrorOther},
+		{"unknown channel", newTestRESTError(http.StatusNotFound, discordgo.ErrCodeUnknownChannel, "Unknown Channel"), discordErrorUnknownResource},
+		{"unknown message", newTestRESTError(http.StatusNotFound, discordgo.ErrCodeUnknownMessage, "Unknown Message"), discordErrorUnknownResource},
+		{"unknown role", newTestRESTError(http.StatusNotFound, discordgo.ErrCodeUnknownRole, "Unknown Role"), discordErrorUnknownResource},
+		{"unknown emoji", newTestRESTError(http.StatusNotFound, discordgo.ErrCodeUnknownEmoji, "Unknown Emoji"), discordErrorUnknownResource},
+		{"404 without body", newTestRESTError(http.StatusNotFound, 0, ""), discordErrorOther},
+		{"missing access", newTestRESTError(http.StatusForbidden, discordgo.ErrCodeMissingAccess, "Missing Access"), discordErrorMissingAccess},
+		{"missing permissions", newTestRESTError(http.StatusForbidden, discordgo.ErrCodeMissingPermissions, "Missing Permissions"), discordErrorMissingPermissions},
+		{"403 without body", newTestRESTError(http.StatusForbidden, 0, ""), discordErrorMissingPermissions},
+		{"401", newTestRESTError(http.StatusUnauthorized, 0, `{"message": "401: Unauthorized", "code": 0}`), discordErrorUnauthorized},
+		{"429", newTestRESTError(http.StatusTooManyRequests, 0, ""), discordErrorRateLimited},
+		{"rate limit error", &discordgo.RateLimitError{RateLimit: &discordgo.RateLimit{TooManyRequests: &discordgo.TooManyRequests{}}}, discordErrorRateLimited},
+		{"community only", newTestRESTError(http.StatusBadRequest, discordgo.ErrCodeCommunityServerChannelsMustBeTextChannels, "Community"), discordErrorCommunityOnly},
+		{"community in message only", newTestRESTError(http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, `{"message": "Invalid Form Body: name COMMUNITY-chat is taken", "code": 50035}`), discordErrorOther},
+		{"wrapped", fmt.Errorf("wrapped: %w", newTestRESTError(http.StatusNotFound, discordgo.ErrCodeUnknownGuild, "Unknown Guild")), discordErrorUnknownResource},
+		{"deadline exceeded", &url.Error{Op: "Get", URL: "https://discord.com/api/v9/guilds/1", Err: context.DeadlineExceeded}, discordErrorTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, classifyDiscordError(tt.err))
+		})
+	}
+}
+
+func TestClassifyMembersError(t *testing.T) {
+	err := newTestRESTError(http.StatusForbidden, discordgo.ErrCodeMissingAccess, "Missing Access")
+	assert.Equal(t, discordErrorMissingIntent, classifyMembersError(err))
+	assert.Contains(t, discordMembersErrorDetail("Unable to fetch members", err), "GUILD_MEMBERS")
+}
+
+func TestDiscordErrorDetail(t *testing.T) {
+	err := newTestRESTError(http.StatusForbidden, discordgo.ErrCodeMissingPermissions, `{"message": "Missing Permissions", "code": 50013}`)
+	detail := discordErrorDetail("Unable to create role", err)
+	assert.Contains(t, detail, "Unable to create role: HTTP 403 Forbidden")
+	assert.Contains(t, detail, "missing a permission")
+
+	assert.Equal(t, "Unable to parse: boom", discordErrorDetail("Unable to parse", errors.New("boom")))
+}
+
+func TestAccBareNotFoundKeepsResourcesInState(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+
+	config := providerConfig + fmt.Sprintf(`
+resource "discord_channel" "test" {
+  guild_id = %q
+  name     = "chat"
+}
+
+resource "discord_role" "test" {
+  guild_id = %q
+  name     = "Members"
+}
+`, guild.ID, guild.ID)
+
+	var channelID, roleID string
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: config,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					testAccCaptureAttr("discord_channel.test", "id", &channelID),
+					testAccCaptureAttr("discord_role.test", "id", &roleID),
+				),
+			},
+			{
+				// A 404 without a Discord error code, as from a misrouted proxy, fails the refresh
+				PreConfig:   func() { s.SetBareNotFound("/channels/") },
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Error Fetching Channel`),
+			},
+			{
+				PreConfig:   func() { s.SetBareNotFound("/guilds/" + guild.ID + "/roles") },
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Error Fetching Roles`),
+			},
+			{
+				// Once the API answers again the same resources are still managed, rather than created anew
+				PreConfig: func() { s.SetBareNotFound("") },
+				Config:    config,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttrPtr("discord_channel.test", "id", &channelID),
+					tfresource.TestCheckResourceAttrPtr("discord_role.test", "id", &roleID),
+				),
+			},
+		},
+	})
+}
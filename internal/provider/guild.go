@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// missingGuildIDDetail explains how to set the guild when neither the resource nor the provider sets one.
+const missingGuildIDDetail = "The guild_id attribute is required. Set guild_id here, or set a default guild with " +
+	"guild_id in the provider configuration or the DISCORD_GUILD_ID environment variable."
+
+// guildIDOrDefault returns the configured guild_id, or the provider's default guild when it is not set.
+func guildIDOrDefault(guildID, defaultGuildID types.String) types.String {
+	if !guildID.IsNull() {
+		return guildID
+	}
+	if defaultGuildID.IsUnknown() || defaultGuildID.ValueString() == "" {
+		return guildID
+	}
+	return defaultGuildID
+}
+
+// modifyPlanGuildID sets guild_id in the plan to the provider's default guild when the configuration does not
+// set it, so the effective guild is shown in the plan and stored in state. Objects cannot move between guilds,
+// so a change of the default guild replaces the resource.
+func modifyPlanGuildID(ctx context.Context, defaultGuildID types.String, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var guildID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("guild_id"), &guildID)...)
+	if resp.Diagnostics.HasError() || !guildID.IsNull() {
+		return
+	}
+
+	switch {
+	case defaultGuildID.IsUnknown():
+		// The guild is known once the provider's guild_id is
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("guild_id"), types.StringUnknown())...)
+	case defaultGuildID.ValueString() == "":
+		resp.Diagnostics.AddAttributeError(path.Root("guild_id"), "Missing Guild ID", missingGuildIDDetail)
+		return
+	default:
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("guild_id"), defaultGuildID)...)
+	}
+
+	if req.State.Raw.IsNull() || defaultGuildID.IsUnknown() {
+		return
+	}
+
+	var stateGuildID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("guild_id"), &stateGuildID)...)
+	if !stateGuildID.IsNull() && !stateGuildID.Equal(defaultGuildID) {
+		resp.RequiresReplace.Append(path.Root("guild_id"))
+	}
+}
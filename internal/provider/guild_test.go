@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuildIDOrDefault(t *testing.T) {
+	tests := []struct {
+		name           string
+		guildID        types.String
+		defaultGuildID types.String
+		want           types.String
+	}{
+		{"configured", types.StringValue("1"), types.StringValue("2"), types.StringValue("1")},
+		{"default", types.StringNull(), types.StringValue("2"), types.StringValue("2")},
+		{"no default", types.StringNull(), types.StringNull(), types.StringNull()},
+		{"empty default", types.StringNull(), types.StringValue(""), types.StringNull()},
+		{"unknown default", types.StringNull(), types.StringUnknown(), types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, guildIDOrDefault(tt.guildID, tt.defaultGuildID))
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// apiLogSubsystem logs every Discord REST request. Its level can be set with TF_LOG_PROVIDER_DISCORD_API.
+	apiLogSubsystem = "api"
+	// rateLimitLogSubsystem logs rate limit waits and retries. Its level can be set with
+	// TF_LOG_PROVIDER_DISCORD_RATE_LIMIT.
+	rateLimitLogSubsystem = "rate_limit"
+)
+
+// maskedLogFieldKeys are the log fields whose values are always masked, so tokens never reach the log.
+var maskedLogFieldKeys = []string{"token", "bot_token", "webhook_token", "authorization"}
+
+// resourceLogContext returns ctx with the resource type and ID as log fields, so every log line and Discord
+// API request made while handling the resource can be traced back to it, and logs the operation.
+func resourceLogContext(ctx context.Context, resourceType, operation string, id types.String) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, maskedLogFieldKeys...)
+	ctx = tflog.SetField(ctx, "resource_type", resourceType)
+	if !id.IsNull() && !id.IsUnknown() && id.ValueString() != "" {
+		ctx = tflog.SetField(ctx, "resource_id", id.ValueString())
+	}
+
+	tflog.Debug(ctx, "Starting Discord resource "+operation)
+	return ctx
+}
+
+// apiLogContext returns ctx with the API and rate limit subsystem loggers. Root fields such as the resource
+// type and ID are copied to both, and the bot token is masked wherever it appears.
+func apiLogContext(ctx context.Context, token string) context.Context {
+	for _, subsystem := range []string{apiLogSubsystem, rateLimitLogSubsystem} {
+		ctx = tflog.NewSubsystem(ctx, subsystem,
+			tflog.WithRootFields(),
+			tflog.WithLevelFromEnv("TF_LOG_PROVIDER_DISCORD", subsystem),
+		)
+		ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, maskedLogFieldKeys...)
+		if token != "" {
+			ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, subsystem, token)
+			ctx = tflog.SubsystemMaskMessageStrings(ctx, subsystem, token)
+		}
+	}
+	return ctx
+}
+
+// routeTemplate returns the API path of a request without the version prefix, with IDs, invite codes and
+// webhook tokens replaced by placeholders, such as "/channels/:id/messages/:id".
+func routeTemplate(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) >= 2 && parts[0] == "api" && strings.HasPrefix(parts[1], "v") {
+		parts = parts[2:]
+	}
+
+	for i, part := range parts {
+		switch {
+		case part != "" && part[0] >= '0' && part[0] <= '9':
+			parts[i] = ":id"
+		case i > 0 && parts[i-1] == "invites":
+			parts[i] = ":code"
+		case i > 1 && parts[i-2] == "webhooks":
+			// Webhook routes carry the webhook token after the webhook ID
+			parts[i] = ":token"
+		}
+	}
+	return "/" + strings.Join(parts, "/")
+}
+
+// discordErrorCode returns the JSON error code of an error response, or 0. The body is left readable.
+func discordErrorCode(resp *http.Response) int {
+	if resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return 0
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return 0
+	}
+
+	var payload struct {
+		Code int `json:"code"`
+	}
+	if json.Unmarshal(body, &payload) != nil {
+		return 0
+	}
+	return payload.Code
+}
+
+// logAPIRequest logs one attempt of a Discord REST request.
+func logAPIRequest(ctx context.Context, req *http.Request, resp *http.Response, err error, attempt int, latency time.Duration) {
+	fields := map[string]interface{}{
+		"method":     req.Method,
+		"route":      routeTemplate(req.URL.Path),
+		"retry":      attempt,
+		"latency_ms": latency.Milliseconds(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemWarn(ctx, apiLogSubsystem, "Discord API request failed", fields)
+		return
+	}
+
+	fields["status"] = resp.StatusCode
+	if bucket := resp.Header.Get("X-RateLimit-Bucket"); bucket != "" {
+		fields["bucket"] = bucket
+	}
+	if code := discordErrorCode(resp); code != 0 {
+		fields["discord_error_code"] = code
+	}
+
+	// Client errors such as 404 are routine while refreshing state; only server errors and 429s are warnings
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		tflog.SubsystemWarn(ctx, apiLogSubsystem, "Discord API request returned an error", fields)
+		return
+	}
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Discord API request", fields)
+}
+
+// logRetry logs that a request is retried after wait because of reason.
+func logRetry(ctx context.Context, req *http.Request, attempt int, wait time.Duration, reason string) {
+	tflog.SubsystemInfo(ctx, rateLimitLogSubsystem, "Retrying Discord API request", map[string]interface{}{
+		"method":  req.Method,
+		"route":   routeTemplate(req.URL.Path),
+		"retry":   attempt + 1,
+		"wait_ms": wait.Milliseconds(),
+		"reason":  reason,
+	})
+}
+
+// logResourceCreated logs the ID Discord assigned to a newly created resource.
+func logResourceCreated(ctx context.Context, id types.String) {
+	tflog.Debug(ctx, "Created Discord resource", map[string]interface{}{"resource_id": id.ValueString()})
+}
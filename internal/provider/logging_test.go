@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteTemplate(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/api/v9/channels/123", "/channels/:id"},
+		{"/api/v10/channels/123/messages/456", "/channels/:id/messages/:id"},
+		{"/api/v9/guilds/1/members/2/roles/3", "/guilds/:id/members/:id/roles/:id"},
+		{"/api/v9/users/@me", "/users/@me"},
+		{"/api/v9/invites/abc", "/invites/:code"},
+		{"/api/v9/webhooks/1/secret-token", "/webhooks/:id/:token"},
+		{"/api/v9/webhooks/1/secret-token/messages/2", "/webhooks/:id/:token/messages/:id"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, routeTemplate(tt.path), tt.path)
+	}
+}
+
+func TestDiscordErrorCode(t *testing.T) {
+	body := `{"message": "Unknown Channel", "code": 10003}`
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+
+	assert.Equal(t, 10003, discordErrorCode(resp))
+
+	// The body is still readable for discordgo
+	remaining, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(remaining))
+
+	ok := &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	assert.Equal(t, 0, discordErrorCode(ok))
+}
+
+func TestLogAPIRequest(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+	ctx = resourceLogContext(ctx, "discord_webhook", "read", types.StringValue("42"))
+	ctx = apiLogContext(ctx, "bot-secret")
+
+	req, err := http.NewRequest(http.MethodGet, "https://discord.com/api/v9/webhooks/42/webhook-secret", nil)
+	require.NoError(t, err)
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Header:     http.Header{"X-Ratelimit-Bucket": []string{"abc"}},
+		Body:       io.NopCloser(strings.NewReader(`{"code": 10015}`)),
+	}
+
+	logAPIRequest(ctx, req, resp, nil, 1, 25*time.Millisecond)
+	logAPIRequest(ctx, req, nil, errors.New("dial failed for Bot bot-secret"), 2, time.Millisecond)
+
+	logged := output.String()
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	request := entries[1]
+	assert.Equal(t, "Discord API request", request["@message"])
+	assert.Equal(t, "discord_webhook", request["resource_type"])
+	assert.Equal(t, "42", request["resource_id"])
+	assert.Equal(t, "GET", request["method"])
+	assert.Equal(t, "/webhooks/:id/:token", request["route"])
+	assert.Equal(t, "abc", request["bucket"])
+	assert.EqualValues(t, 404, request["status"])
+	assert.EqualValues(t, 10015, request["discord_error_code"])
+	assert.EqualValues(t, 1, request["retry"])
+
+	failed := entries[2]
+	assert.Equal(t, "warn", failed["@level"])
+	assert.NotContains(t, failed["error"], "bot-secret")
+	assert.NotContains(t, logged, "webhook-secret")
+}
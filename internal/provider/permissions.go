@@ -0,0 +1,93 @@
+package provider
+
+// permissionFlags are Discord's permission flags with their API names, in bit order.
+var permissionFlags = []struct {
+	bit  int64
+	name string
+}{
+	{1 << 0, "CREATE_INSTANT_INVITE"},
+	{1 << 1, "KICK_MEMBERS"},
+	{1 << 2, "BAN_MEMBERS"},
+	{1 << 3, "ADMINISTRATOR"},
+	{1 << 4, "MANAGE_CHANNELS"},
+	{1 << 5, "MANAGE_GUILD"},
+	{1 << 6, "ADD_REACTIONS"},
+	{1 << 7, "VIEW_AUDIT_LOG"},
+	{1 << 8, "PRIORITY_SPEAKER"},
+	{1 << 9, "STREAM"},
+	{1 << 10, "VIEW_CHANNEL"},
+	{1 << 11, "SEND_MESSAGES"},
+	{1 << 12, "SEND_TTS_MESSAGES"},
+	{1 << 13, "MANAGE_MESSAGES"},
+	{1 << 14, "EMBED_LINKS"},
+	{1 << 15, "ATTACH_FILES"},
+	{1 << 16, "READ_MESSAGE_HISTORY"},
+	{1 << 17, "MENTION_EVERYONE"},
+	{1 << 18, "USE_EXTERNAL_EMOJIS"},
+	{1 << 19, "VIEW_GUILD_INSIGHTS"},
+	{1 << 20, "CONNECT"},
+	{1 << 21, "SPEAK"},
+	{1 << 22, "MUTE_MEMBERS"},
+	{1 << 23, "DEAFEN_MEMBERS"},
+	{1 << 24, "MOVE_MEMBERS"},
+	{1 << 25, "USE_VAD"},
+	{1 << 26, "CHANGE_NICKNAME"},
+	{1 << 27, "MANAGE_NICKNAMES"},
+	{1 << 28, "MANAGE_ROLES"},
+	{1 << 29, "MANAGE_WEBHOOKS"},
+	{1 << 30, "MANAGE_GUILD_EXPRESSIONS"},
+	{1 << 31, "USE_APPLICATION_COMMANDS"},
+	{1 << 32, "REQUEST_TO_SPEAK"},
+	{1 << 33, "MANAGE_EVENTS"},
+	{1 << 34, "MANAGE_THREADS"},
+	{1 << 35, "CREATE_PUBLIC_THREADS"},
+	{1 << 36, "CREATE_PRIVATE_THREADS"},
+	{1 << 37, "USE_EXTERNAL_STICKERS"},
+	{1 << 38, "SEND_MESSAGES_IN_THREADS"},
+	{1 << 39, "USE_EMBEDDED_ACTIVITIES"},
+	{1 << 40, "MODERATE_MEMBERS"},
+	{1 << 41, "VIEW_CREATOR_MONETIZATION_ANALYTICS"},
+	{1 << 42, "USE_SOUNDBOARD"},
+	{1 << 43, "CREATE_GUILD_EXPRESSIONS"},
+	{1 << 44, "CREATE_EVENTS"},
+	{1 << 45, "USE_EXTERNAL_SOUNDS"},
+	{1 << 46, "SEND_VOICE_MESSAGES"},
+	{1 << 49, "SEND_POLLS"},
+	{1 << 50, "USE_EXTERNAL_APPS"},
+}
+
+// allPermissions is every permission flag, as granted to administrators and the guild owner.
+var allPermissions = func() int64 {
+	var all int64
+	for _, flag := range permissionFlags {
+		all |= flag.bit
+	}
+	return all
+}()
+
+// permissionNames returns the names of the permission flags set in permissions, in bit order. Unknown bits are
+// left out.
+func permissionNames(permissions int64) []string {
+	names := []string{}
+	for _, flag := range permissionFlags {
+		if permissions&flag.bit != 0 {
+			names = append(names, flag.name)
+		}
+	}
+	return names
+}
+
+// permissionFlagNames returns the names of every permission flag, in bit order.
+func permissionFlagNames() []string {
+	return permissionNames(allPermissions)
+}
+
+// permissionBit returns the bit of the named permission flag, or 0 if the name is unknown.
+func permissionBit(name string) int64 {
+	for _, flag := range permissionFlags {
+		if flag.name == name {
+			return flag.bit
+		}
+	}
+	return 0
+}
@@ -0,0 +1,16 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionNames(t *testing.T) {
+	assert.Empty(t, permissionNames(0))
+	assert.Equal(t, []string{"ADMINISTRATOR"}, permissionNames(discordgo.PermissionAdministrator))
+	assert.Equal(t, []string{"VIEW_CHANNEL", "SEND_MESSAGES"}, permissionNames(discordgo.PermissionViewChannel|discordgo.PermissionSendMessages))
+	assert.Equal(t, []string{"USE_EXTERNAL_APPS"}, permissionNames(1<<50|1<<60), "unknown bits are left out")
+	assert.Len(t, permissionNames(allPermissions), len(permissionFlags))
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -26,7 +28,27 @@ type discordProvider struct {
 
 // discordProviderModel describes the provider data model.
 type discordProviderModel struct {
-	Token types.String `tfsdk:"token"`
+	Token           types.String `tfsdk:"token"`
+	OpenGateway     types.Bool   `tfsdk:"open_gateway"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	MaxBackoff      types.String `tfsdk:"max_backoff"`
+	APIURL          types.String `tfsdk:"api_url"`
+	APIVersion      types.String `tfsdk:"api_version"`
+	HTTPProxy       types.String `tfsdk:"http_proxy"`
+	RequestTimeout  types.String `tfsdk:"request_timeout"`
+	UserAgentSuffix types.String `tfsdk:"user_agent_suffix"`
+	GuildID         types.String `tfsdk:"guild_id"`
+}
+
+// discordProviderData is passed from the provider to resources and data sources when they are configured.
+type discordProviderData struct {
+	client *discordgo.Session
+	// guildID is the default guild for resources and data sources that do not set guild_id. It is null when no
+	// default is configured, and unknown while the provider's guild_id depends on a value not yet known.
+	guildID types.String
+	// apiURL is the base URL of the REST API, without the version, used to build the webhook URLs that
+	// resources and data sources return.
+	apiURL string
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -60,6 +82,57 @@ func (p *discordProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Optional:  true,
 				Sensitive: true,
 			},
+			"open_gateway": schema.BoolAttribute{
+				Description: "Whether to open a gateway websocket connection in addition to the REST API client. " +
+					"All resources and data sources work over REST, so this defaults to false. Enable it only for data that Discord sends over the gateway alone. " +
+					"Opening the gateway adds startup latency and counts against Discord's daily IDENTIFY limit. " +
+					"Can also be set with the DISCORD_OPEN_GATEWAY environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "How many times a request is retried after a 429 rate limit response or, for idempotent requests, " +
+					"a 5xx response or network error. Defaults to 3. Set to 0 to disable retries.",
+				Optional: true,
+			},
+			"max_backoff": schema.StringAttribute{
+				Description: "The longest time to wait before retrying a request, as a Go duration such as \"30s\" or \"2m\". " +
+					"A 429 response that asks for a longer wait is returned as an error instead of retried. Defaults to \"30s\".",
+				Optional: true,
+			},
+			"api_url": schema.StringAttribute{
+				Description: "The base URL of the Discord REST API, without the version. Defaults to \"https://discord.com/api\". " +
+					"Set this to point the provider at a local server that speaks the Discord REST API, for example in tests. Webhook URLs are built under this URL too. " +
+					"Can also be set with the DISCORD_API_URL environment variable.",
+				Optional: true,
+			},
+			"api_version": schema.StringAttribute{
+				Description: "The Discord REST API version to use, such as \"10\". Defaults to the version supported by the provider's Discord library. " +
+					"Can also be set with the DISCORD_API_VERSION environment variable.",
+				Optional: true,
+			},
+			"http_proxy": schema.StringAttribute{
+				Description: "The URL of an HTTP proxy to send Discord API requests through, such as \"http://proxy.example.com:3128\". " +
+					"Can also be set with the DISCORD_HTTP_PROXY environment variable. " +
+					"When neither is set, the standard HTTPS_PROXY and NO_PROXY environment variables are used.",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "The timeout for each attempt of a Discord API request, as a Go duration such as \"20s\". Defaults to \"20s\". " +
+					"Time spent waiting for rate limits or between retries does not count towards it. " +
+					"Can also be set with the DISCORD_REQUEST_TIMEOUT environment variable.",
+				Optional: true,
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				Description: "Text appended to the User-Agent header of every Discord API request, for example to identify a CI pipeline. " +
+					"Can also be set with the DISCORD_USER_AGENT_SUFFIX environment variable.",
+				Optional: true,
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild (server) that resources and data sources use when they do not set guild_id. " +
+					"Resources still store the guild they use in state, so plans show the effective guild. " +
+					"Can also be set with the DISCORD_GUILD_ID environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -89,6 +162,13 @@ func (p *discordProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	opts, diags := clientOptionsFromConfig(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opts.token = strings.TrimPrefix(token, "Bot ")
+
 	// Create Discord session
 	// Discord bot tokens should be prefixed with "Bot "
 	tokenPrefix := "Bot "
@@ -105,20 +185,66 @@ func (p *discordProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	// Open the websocket and begin listening
-	err = dg.Open()
-	if err != nil {
+	// Route every request through the shared rate limit aware transport. discordgo's own retries of
+	// 429 and 502 responses are disabled so retries are not multiplied.
+	dg.Client = newHTTPClient(opts)
+	dg.ShouldRetryOnRateLimit = false
+	dg.MaxRestRetries = 0
+	if opts.userAgentSuffix != "" {
+		dg.UserAgent += " " + opts.userAgentSuffix
+	}
+
+	// Validate the token with a cheap REST call instead of opening the gateway
+	if _, err := dg.User("@me", discordgo.WithContext(ctx)); err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Open Discord Connection",
-			fmt.Sprintf("An unexpected error occurred when opening the Discord connection: %s", err.Error()),
+			"Unable to Authenticate With Discord",
+			discordErrorDetail("The Discord bot token could not be validated", err),
 		)
 		return
 	}
 
-	// Make the Discord session available during DataSource and Resource
+	openGateway := config.OpenGateway.ValueBool()
+	if config.OpenGateway.IsNull() {
+		if envOpenGateway := os.Getenv("DISCORD_OPEN_GATEWAY"); envOpenGateway != "" {
+			parsed, err := strconv.ParseBool(envOpenGateway)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Invalid Open Gateway",
+					fmt.Sprintf("DISCORD_OPEN_GATEWAY must be true or false, got %q.", envOpenGateway),
+				)
+				return
+			}
+			openGateway = parsed
+		}
+	}
+
+	// Open the websocket only when explicitly requested
+	if openGateway {
+		if err := dg.Open(); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Open Discord Connection",
+				fmt.Sprintf("An unexpected error occurred when opening the Discord gateway connection: %s", err.Error()),
+			)
+			return
+		}
+	}
+
+	guildID := config.GuildID
+	if guildID.IsNull() {
+		if envGuildID := os.Getenv("DISCORD_GUILD_ID"); envGuildID != "" {
+			guildID = types.StringValue(envGuildID)
+		}
+	}
+
+	// Make the Discord session and default guild available during DataSource and Resource
 	// Configure methods.
-	resp.ResourceData = dg
-	resp.DataSourceData = dg
+	providerData := &discordProviderData{
+		client:  dg,
+		guildID: guildID,
+		apiURL:  opts.apiURL.String(),
+	}
+	resp.ResourceData = providerData
+	resp.DataSourceData = providerData
 }
 
 // Resources defines the resources implemented by the provider.
@@ -134,6 +260,10 @@ func (p *discordProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewWebhookResource,
 		NewMessageResource,
 		NewRoleMemberResource,
+		NewRoleMembersResource,
+		NewMemberRolesResource,
+		NewRoleOrderResource,
+		NewChannelOrderResource,
 		NewEmojiResource,
 	}
 }
@@ -150,8 +280,17 @@ func (p *discordProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewRoleDataSource,
 		NewColorDataSource,
 		NewMemberDataSource,
+		NewUserDataSource,
 		NewMembersDataSource,
 		NewEmojisDataSource,
 		NewEmojiDataSource,
+		NewAuditLogDataSource,
+		NewWebhooksDataSource,
+		NewWebhookDataSource,
+		NewInvitesDataSource,
+		NewInviteDataSource,
+		NewCurrentUserDataSource,
+		NewApplicationDataSource,
+		NewEffectivePermissionsDataSource,
 	}
 }
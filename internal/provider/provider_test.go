@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+	"github.com/tfstack/terraform-provider-discord/internal/fakediscord"
+)
+
+// testAccProtoV6ProviderFactories serves the provider in-process for acceptance tests.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"discord": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccFakeDiscord starts a fake Discord API for one acceptance test and returns it with a provider
+// block that targets it. Acceptance tests run against the fake, so they need no bot token or network.
+func testAccFakeDiscord(t *testing.T) (*fakediscord.Server, string) {
+	t.Helper()
+
+	s := fakediscord.New()
+	t.Cleanup(s.Close)
+
+	providerConfig := fmt.Sprintf(`
+provider "discord" {
+  token       = %q
+  api_url     = %q
+  max_backoff = "1s"
+}
+`, fakediscord.Token, s.URL)
+
+	return s, providerConfig
+}
+
+// testFakeClient returns a Discord session that sends its requests to s, configured like the provider's, for
+// tests that call resource methods directly.
+func testFakeClient(t *testing.T, s *fakediscord.Server) *discordgo.Session {
+	t.Helper()
+
+	opts, diags := clientOptionsFromConfig(discordProviderModel{APIURL: types.StringValue(s.URL)})
+	require.False(t, diags.HasError())
+	opts.token = fakediscord.Token
+
+	dg, err := discordgo.New("Bot " + fakediscord.Token)
+	require.NoError(t, err)
+	dg.Client = newHTTPClient(opts)
+	dg.ShouldRetryOnRateLimit = false
+	dg.MaxRestRetries = 0
+	return dg
+}
+
+// testAccProviderConfigWithGuild returns a provider block that targets s and sets guildID as the default guild.
+func testAccProviderConfigWithGuild(s *fakediscord.Server, guildID string) string {
+	return fmt.Sprintf(`
+provider "discord" {
+  token       = %q
+  api_url     = %q
+  max_backoff = "1s"
+  guild_id    = %q
+}
+`, fakediscord.Token, s.URL, guildID)
+}
+
+// testAccAttr returns the value of an attribute of a resource in the state.
+func testAccAttr(state *terraform.State, name, attribute string) (string, error) {
+	rs, ok := state.RootModule().Resources[name]
+	if !ok {
+		return "", fmt.Errorf("resource %s not found in state", name)
+	}
+	value, ok := rs.Primary.Attributes[attribute]
+	if !ok {
+		return "", fmt.Errorf("attribute %s not found on %s", attribute, name)
+	}
+	return value, nil
+}
+
+// testAccImportID returns an ImportStateIdFunc that joins attributes of a resource with colons.
+func testAccImportID(name string, attributes ...string) func(*terraform.State) (string, error) {
+	return func(state *terraform.State) (string, error) {
+		id := ""
+		for i, attribute := range attributes {
+			value, err := testAccAttr(state, name, attribute)
+			if err != nil {
+				return "", err
+			}
+			if i > 0 {
+				id += ":"
+			}
+			id += value
+		}
+		return id, nil
+	}
+}
+
+// testAccCaptureAttr stores the value of an attribute in dest, so later steps can refer to the object.
+func testAccCaptureAttr(name, attribute string, dest *string) func(*terraform.State) error {
+	return func(state *terraform.State) error {
+		value, err := testAccAttr(state, name, attribute)
+		*dest = value
+		return err
+	}
+}
+
+// testAccStringList formats values as an HCL list of strings.
+func testAccStringList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+func TestAccProvider_openGateway(t *testing.T) {
+	t.Setenv("DISCORD_OPEN_GATEWAY", "")
+	s, providerConfig := testAccFakeDiscord(t)
+
+	var seen int
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				// The fake API has no gateway, so opting in fails when the provider tries to open it
+				Config: fmt.Sprintf(`
+provider "discord" {
+  token        = %q
+  api_url      = %q
+  open_gateway = true
+}
+
+data "discord_current_user" "test" {}
+`, fakediscord.Token, s.URL),
+				ExpectError: regexp.MustCompile(`Unable to Open Discord Connection`),
+			},
+			{
+				// By default the provider only validates the token over REST
+				PreConfig: func() { seen = len(s.Requests()) },
+				Config:    providerConfig + `data "discord_current_user" "test" {}`,
+				Check: func(*terraform.State) error {
+					for _, request := range s.Requests()[seen:] {
+						if strings.Contains(request, "/gateway") {
+							return fmt.Errorf("the gateway was requested without open_gateway: %s", request)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -5,27 +5,37 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the resource type implements the required interfaces.
 var _ resource.Resource = &categoryResource{}
 var _ resource.ResourceWithConfigure = &categoryResource{}
+var _ resource.ResourceWithModifyPlan = &categoryResource{}
 var _ resource.ResourceWithImportState = &categoryResource{}
 
 // categoryResource defines the resource implementation.
 type categoryResource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // categoryResourceModel describes the resource data model.
 type categoryResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	GuildID  types.String `tfsdk:"guild_id"`
-	Position types.Int64  `tfsdk:"position"`
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	GuildID  types.String   `tfsdk:"guild_id"`
+	Position types.Int64    `tfsdk:"position"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewCategoryResource is a helper function to simplify testing.
@@ -39,27 +49,52 @@ func (r *categoryResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *categoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *categoryResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates and manages a Discord category channel in a guild (server). Category channels are organizational containers that group other channels together.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of the category channel.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the category channel. Must be 1-100 characters.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
 			},
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the guild (server) where the category will be created.",
-				Required:    true,
+				Description: "The ID of the guild (server) where the category will be created. Changing this forces a new resource. Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"position": schema.Int64Attribute{
 				Description: "The position of the category in the channel list. Lower numbers appear higher in the list.",
 				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -69,16 +104,22 @@ func (r *categoryResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.defaultGuildID = providerData.guildID
+}
+
+// ModifyPlan fills in guild_id from the provider's default guild when it is not configured.
+func (r *categoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanGuildID(ctx, r.defaultGuildID, req, resp)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -91,6 +132,17 @@ func (r *categoryResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_category", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -125,11 +177,11 @@ func (r *categoryResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Create the category channel
-	channel, err := r.client.GuildChannelCreateComplex(guildID, channelData)
+	channel, err := r.client.GuildChannelCreateComplex(guildID, channelData, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Category",
-			fmt.Sprintf("Unable to create category %s in guild %s: %s", name, guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to create category %s in guild %s", name, guildID), err),
 		)
 		return
 	}
@@ -169,11 +221,11 @@ func (r *categoryResource) Create(ctx context.Context, req resource.CreateReques
 		position := int(planPosition.ValueInt64())
 		updatedChannel, err := r.client.ChannelEditComplex(channel.ID, &discordgo.ChannelEdit{
 			Position: &position,
-		})
+		}, discordgo.WithContext(ctx))
 		if err != nil {
 			resp.Diagnostics.AddWarning(
 				"Error Setting Category Position",
-				fmt.Sprintf("Category was created but position could not be set: %s", err.Error()),
+				discordErrorDetail("Category was created but position could not be set", err),
 			)
 		} else {
 			// Use updated channel if position was set successfully
@@ -193,6 +245,8 @@ func (r *categoryResource) Create(ctx context.Context, req resource.CreateReques
 		data.Position = types.Int64Null()
 	}
 
+	logResourceCreated(ctx, data.ID)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -216,6 +270,17 @@ func (r *categoryResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_category", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -235,11 +300,19 @@ func (r *categoryResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Fetch the channel
-	channel, err := r.client.Channel(categoryID)
+	channel, err := r.client.Channel(categoryID, discordgo.WithContext(ctx))
 	if err != nil {
+		if isDiscordNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Category Not Found",
+				fmt.Sprintf("Category %s was not found. It may have been deleted outside of Terraform. Removing from state.", categoryID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Fetching Category",
-			fmt.Sprintf("Unable to fetch category %s: %s", categoryID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch category %s", categoryID), err),
 		)
 		return
 	}
@@ -283,6 +356,17 @@ func (r *categoryResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_category", "update", state.ID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -323,11 +407,11 @@ func (r *categoryResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// Apply updates if any
 	if hasChanges {
-		channel, err := r.client.ChannelEditComplex(categoryID, edit)
+		channel, err := r.client.ChannelEditComplex(categoryID, edit, discordgo.WithContext(ctx))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating Category",
-				fmt.Sprintf("Unable to update category %s: %s", categoryID, err.Error()),
+				discordErrorDetail(fmt.Sprintf("Unable to update category %s", categoryID), err),
 			)
 			return
 		}
@@ -363,6 +447,17 @@ func (r *categoryResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_category", "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -379,11 +474,11 @@ func (r *categoryResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	// Delete the category channel
 	// Note: Deleting a category will also delete all channels within it
-	_, err := r.client.ChannelDelete(categoryID)
+	_, err := r.client.ChannelDelete(categoryID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Category",
-			fmt.Sprintf("Unable to delete category %s: %s", categoryID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to delete category %s", categoryID), err),
 		)
 		return
 	}
@@ -391,6 +486,8 @@ func (r *categoryResource) Delete(ctx context.Context, req resource.DeleteReques
 
 // ImportState imports an existing resource into Terraform state.
 func (r *categoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_category", "import", types.StringValue(req.ID))
+
 	// The import ID is the category channel ID
 	categoryID := req.ID
 
@@ -404,11 +501,11 @@ func (r *categoryResource) ImportState(ctx context.Context, req resource.ImportS
 	}
 
 	// Fetch the channel to verify it's a category and populate state
-	channel, err := r.client.Channel(categoryID)
+	channel, err := r.client.Channel(categoryID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Category",
-			fmt.Sprintf("Unable to fetch category %s: %s", categoryID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch category %s", categoryID), err),
 		)
 		return
 	}
@@ -424,6 +521,7 @@ func (r *categoryResource) ImportState(ctx context.Context, req resource.ImportS
 
 	// Create a model with the category data
 	var data categoryResourceModel
+	data.Timeouts = nullTimeouts()
 	data.ID = types.StringValue(channel.ID)
 	data.Name = types.StringValue(channel.Name)
 	data.GuildID = types.StringValue(channel.GuildID)
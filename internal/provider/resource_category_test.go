@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,7 +40,8 @@ func TestCategoryResource_Schema(t *testing.T) {
 
 	guildIDAttr, ok := resp.Schema.Attributes["guild_id"]
 	assert.True(t, ok)
-	assert.True(t, guildIDAttr.IsRequired())
+	assert.True(t, guildIDAttr.IsOptional(), "guild_id defaults to the provider's guild_id")
+	assert.True(t, guildIDAttr.IsComputed())
 
 	// Check optional attributes
 	positionAttr, ok := resp.Schema.Attributes["position"]
@@ -58,8 +62,8 @@ func TestCategoryResource_Configure(t *testing.T) {
 		errorContains string
 	}{
 		{
-			name:         "valid discordgo.Session",
-			providerData: &discordgo.Session{},
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
 			expectError:  false,
 		},
 		{
@@ -97,7 +101,61 @@ func TestCategoryResource_Configure(t *testing.T) {
 	}
 }
 
-// Note: Tests for Create, Read, Update, and Delete methods that require Discord API calls
-// should be implemented as acceptance tests with TF_ACC=1 environment variable set.
-// These unit tests verify the schema, metadata, and configuration validation
-// without making API calls.
+func TestAccCategoryResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+
+	var categoryID string
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + testAccCategoryResourceConfig(guild.ID, "General"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_category.test", "name", "General"),
+					tfresource.TestCheckResourceAttr("discord_category.test", "guild_id", guild.ID),
+					testAccCaptureAttr("discord_category.test", "id", &categoryID),
+				),
+			},
+			{
+				ResourceName:            "discord_category.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"position"},
+			},
+			{
+				Config: providerConfig + testAccCategoryResourceConfig(guild.ID, "Community"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_category.test", "name", "Community"),
+					func(*terraform.State) error {
+						if channel, ok := s.Channel(categoryID); !ok || channel.Name != "Community" {
+							return fmt.Errorf("category %s was not renamed in place", categoryID)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// A category deleted outside Terraform is removed from state and created again
+				PreConfig: func() { s.DeleteChannel(categoryID) },
+				Config:    providerConfig + testAccCategoryResourceConfig(guild.ID, "Community"),
+				Check: func(state *terraform.State) error {
+					id, err := testAccAttr(state, "discord_category.test", "id")
+					if err == nil && id == categoryID {
+						return fmt.Errorf("category was not recreated after it was deleted")
+					}
+					return err
+				},
+			},
+		},
+	})
+}
+
+func testAccCategoryResourceConfig(guildID, name string) string {
+	return fmt.Sprintf(`
+resource "discord_category" "test" {
+  guild_id = %q
+  name     = %q
+}
+`, guildID, name)
+}
@@ -5,29 +5,41 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the resource type implements the required interfaces.
 var _ resource.Resource = &channelResource{}
 var _ resource.ResourceWithConfigure = &channelResource{}
+var _ resource.ResourceWithModifyPlan = &channelResource{}
+var _ resource.ResourceWithValidateConfig = &channelResource{}
 var _ resource.ResourceWithImportState = &channelResource{}
 
 // channelResource defines the resource implementation.
 type channelResource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // channelResourceModel describes the resource data model.
 type channelResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	Type       types.String `tfsdk:"type"`
-	GuildID    types.String `tfsdk:"guild_id"`
-	CategoryID types.String `tfsdk:"category_id"`
-	Position   types.Int64  `tfsdk:"position"`
+	ID         types.String   `tfsdk:"id"`
+	Name       types.String   `tfsdk:"name"`
+	Type       types.String   `tfsdk:"type"`
+	GuildID    types.String   `tfsdk:"guild_id"`
+	CategoryID types.String   `tfsdk:"category_id"`
+	Position   types.Int64    `tfsdk:"position"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewChannelResource is a helper function to simplify testing.
@@ -91,36 +103,71 @@ func (r *channelResource) Metadata(_ context.Context, req resource.MetadataReque
 }
 
 // Schema defines the schema for the resource.
-func (r *channelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *channelResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates and manages a Discord channel in a guild (server).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of the channel.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the channel. Must be 1-100 characters.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
 			},
 			"type": schema.StringAttribute{
-				Description: "The type of the channel. Valid values: \"text\" (text chat channel), \"voice\" (voice channel), \"category\" (organizational container), \"media\" (media channel), \"directory\" (directory channel). Defaults to \"text\". Note: News, stage, and forum channels cannot be created by bots - they must be created manually in Discord or via user OAuth2 tokens.",
+				Description: "The type of the channel. Valid values: \"text\" (text chat channel), \"voice\" (voice channel), \"category\" (organizational container), \"media\" (media channel), \"directory\" (directory channel). Defaults to \"text\". Note: News, stage, and forum channels cannot be created by bots - they must be created manually in Discord or via user OAuth2 tokens. Changing this forces a new resource.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("text", "voice", "category", "media", "directory"),
+				},
 			},
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the guild (server) where the channel will be created.",
-				Required:    true,
+				Description: "The ID of the guild (server) where the channel will be created. Changing this forces a new resource. Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"category_id": schema.StringAttribute{
 				Description: "The ID of the parent category channel. If provided, the channel will be created under this category. Note: Category channels (type=\"category\") cannot have a parent category.",
 				Optional:    true,
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"position": schema.Int64Attribute{
 				Description: "The position of the channel in the channel list. Lower numbers appear higher in the list.",
 				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -130,16 +177,48 @@ func (r *channelResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.defaultGuildID = providerData.guildID
+}
+
+// ValidateConfig rejects text channel names Discord would rewrite, which would otherwise show a diff on every plan.
+func (r *channelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data channelResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.IsNull() || data.Name.IsUnknown() || data.Type.IsUnknown() {
+		return
+	}
+	if !data.Type.IsNull() && data.Type.ValueString() != "text" {
+		return
+	}
+
+	name := data.Name.ValueString()
+	if want := textChannelName(name); want != name {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Invalid Text Channel Name",
+			fmt.Sprintf("Discord stores text channel names in lowercase with hyphens instead of spaces, so %q would be saved as %q. Use %q instead.", name, want, want),
+		)
+	}
+}
+
+// ModifyPlan fills in guild_id from the provider's default guild when it is not configured.
+func (r *channelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanGuildID(ctx, r.defaultGuildID, req, resp)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -152,6 +231,17 @@ func (r *channelResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_channel", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -243,13 +333,13 @@ func (r *channelResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Create the channel
-	channel, err := r.client.GuildChannelCreateComplex(guildID, channelData)
+	channel, err := r.client.GuildChannelCreateComplex(guildID, channelData, discordgo.WithContext(ctx))
 	if err != nil {
 		// Provide more helpful error messages for specific channel types
-		errorMsg := fmt.Sprintf("Unable to create channel %s in guild %s: %s", name, guildID, err.Error())
+		errorMsg := discordErrorDetail(fmt.Sprintf("Unable to create channel %s in guild %s", name, guildID), err)
 
 		// Check for specific channel type errors
-		if channelType == discordgo.ChannelTypeGuildDirectory {
+		if channelType == discordgo.ChannelTypeGuildDirectory && classifyDiscordError(err) != discordErrorCommunityOnly {
 			errorMsg += "\n\nNote: Directory channels are only available in Community servers."
 		}
 
@@ -287,11 +377,11 @@ func (r *channelResource) Create(ctx context.Context, req resource.CreateRequest
 		position := int(planPosition.ValueInt64())
 		updatedChannel, err := r.client.ChannelEditComplex(channel.ID, &discordgo.ChannelEdit{
 			Position: &position,
-		})
+		}, discordgo.WithContext(ctx))
 		if err != nil {
 			resp.Diagnostics.AddWarning(
 				"Error Setting Channel Position",
-				fmt.Sprintf("Channel was created but position could not be set: %s", err.Error()),
+				discordErrorDetail("Channel was created but position could not be set", err),
 			)
 		} else {
 			// Use updated channel if position was set successfully
@@ -325,6 +415,8 @@ func (r *channelResource) Create(ctx context.Context, req resource.CreateRequest
 		data.CategoryID = types.StringNull()
 	}
 
+	logResourceCreated(ctx, data.ID)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -348,6 +440,17 @@ func (r *channelResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_channel", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -367,11 +470,19 @@ func (r *channelResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Fetch the channel
-	channel, err := r.client.Channel(channelID)
+	channel, err := r.client.Channel(channelID, discordgo.WithContext(ctx))
 	if err != nil {
+		if isDiscordNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Channel Not Found",
+				fmt.Sprintf("Channel %s was not found. It may have been deleted outside of Terraform. Removing from state.", channelID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Fetching Channel",
-			fmt.Sprintf("Unable to fetch channel %s: %s", channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch channel %s", channelID), err),
 		)
 		return
 	}
@@ -419,6 +530,17 @@ func (r *channelResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_channel", "update", state.ID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -479,11 +601,11 @@ func (r *channelResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Apply updates if any
 	if hasChanges {
-		channel, err := r.client.ChannelEditComplex(channelID, edit)
+		channel, err := r.client.ChannelEditComplex(channelID, edit, discordgo.WithContext(ctx))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating Channel",
-				fmt.Sprintf("Unable to update channel %s: %s", channelID, err.Error()),
+				discordErrorDetail(fmt.Sprintf("Unable to update channel %s", channelID), err),
 			)
 			return
 		}
@@ -493,7 +615,11 @@ func (r *channelResource) Update(ctx context.Context, req resource.UpdateRequest
 		plan.Name = types.StringValue(channel.Name)
 		plan.Type = types.StringValue(channelTypeToString(channel.Type))
 		plan.GuildID = types.StringValue(channel.GuildID)
-		plan.Position = types.Int64Value(int64(channel.Position))
+
+		// Only track position if it is configured, as in Create and Read
+		if !plan.Position.IsNull() && !plan.Position.IsUnknown() {
+			plan.Position = types.Int64Value(int64(channel.Position))
+		}
 
 		if channel.ParentID != "" {
 			plan.CategoryID = types.StringValue(channel.ParentID)
@@ -524,6 +650,17 @@ func (r *channelResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_channel", "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -539,11 +676,11 @@ func (r *channelResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	// Delete the channel
-	_, err := r.client.ChannelDelete(channelID)
+	_, err := r.client.ChannelDelete(channelID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Channel",
-			fmt.Sprintf("Unable to delete channel %s: %s", channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to delete channel %s", channelID), err),
 		)
 		return
 	}
@@ -551,6 +688,8 @@ func (r *channelResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 // ImportState imports an existing resource into Terraform state.
 func (r *channelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_channel", "import", types.StringValue(req.ID))
+
 	// The import ID is the channel ID
 	channelID := req.ID
 
@@ -564,17 +703,18 @@ func (r *channelResource) ImportState(ctx context.Context, req resource.ImportSt
 	}
 
 	// Fetch the channel to populate state
-	channel, err := r.client.Channel(channelID)
+	channel, err := r.client.Channel(channelID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Channel",
-			fmt.Sprintf("Unable to fetch channel %s: %s", channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch channel %s", channelID), err),
 		)
 		return
 	}
 
 	// Create a model with the channel data
 	var data channelResourceModel
+	data.Timeouts = nullTimeouts()
 	data.ID = types.StringValue(channel.ID)
 	data.Name = types.StringValue(channel.Name)
 	data.Type = types.StringValue(channelTypeToString(channel.Type))
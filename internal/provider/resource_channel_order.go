@@ -0,0 +1,805 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the resource type implements the required interfaces.
+var _ resource.Resource = &channelOrderResource{}
+var _ resource.ResourceWithConfigure = &channelOrderResource{}
+var _ resource.ResourceWithImportState = &channelOrderResource{}
+var _ resource.ResourceWithModifyPlan = &channelOrderResource{}
+
+// channelOrderResource defines the resource implementation.
+type channelOrderResource struct {
+	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
+}
+
+// channelOrderResourceModel describes the resource data model.
+type channelOrderResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	GuildID    types.String   `tfsdk:"guild_id"`
+	ChannelIDs types.List     `tfsdk:"channel_ids"`
+	Categories types.List     `tfsdk:"categories"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// channelOrderCategoryModel describes a single category in the layout.
+type channelOrderCategoryModel struct {
+	CategoryID      types.String `tfsdk:"category_id"`
+	ChannelIDs      types.List   `tfsdk:"channel_ids"`
+	LockPermissions types.Bool   `tfsdk:"lock_permissions"`
+}
+
+// channelOrderCategoryAttrTypes are the attribute types of a category in the layout.
+var channelOrderCategoryAttrTypes = map[string]attr.Type{
+	"category_id":      types.StringType,
+	"channel_ids":      types.ListType{ElemType: types.StringType},
+	"lock_permissions": types.BoolType,
+}
+
+// channelLayout is the ordered channel layout of a guild.
+type channelLayout struct {
+	// channelIDs are the channels without a category, in order.
+	channelIDs []string
+	// categories are the categories in order, each with its channels in order.
+	categories []channelLayoutCategory
+}
+
+// channelLayoutCategory is a category and its ordered channels.
+type channelLayoutCategory struct {
+	id              string
+	channelIDs      []string
+	lockPermissions types.Bool
+}
+
+// channelPositionUpdate is a single entry of the Modify Guild Channel Positions request.
+// discordgo's GuildChannelsReorder only sends id and position, so the request is built here.
+type channelPositionUpdate struct {
+	ID              string  `json:"id"`
+	Position        int     `json:"position"`
+	ParentID        *string `json:"parent_id"`
+	LockPermissions *bool   `json:"lock_permissions,omitempty"`
+}
+
+// NewChannelOrderResource is a helper function to simplify testing.
+func NewChannelOrderResource() resource.Resource {
+	return &channelOrderResource{}
+}
+
+// channelLayoutPositions converts a layout into position updates for the guild's channels. Channels without a
+// category, categories, and the channels inside each listed category are numbered from 0 in the order they are
+// listed. Siblings that are not listed keep their relative order after the listed ones and are renumbered too,
+// so no two siblings share a position.
+func channelLayoutPositions(layout channelLayout, channels []*discordgo.Channel) []channelPositionUpdate {
+	listed := make(map[string]struct{})
+	for _, channelID := range layout.channelIDs {
+		listed[channelID] = struct{}{}
+	}
+	for _, category := range layout.categories {
+		listed[category.id] = struct{}{}
+		for _, channelID := range category.channelIDs {
+			listed[channelID] = struct{}{}
+		}
+	}
+
+	sorted := make([]*discordgo.Channel, len(channels))
+	copy(sorted, channels)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Position != sorted[j].Position {
+			return sorted[i].Position < sorted[j].Position
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	// Collect the unlisted siblings of every group the layout renumbers, in their current order
+	var unlistedTop, unlistedCategories []string
+	unlistedInCategory := make(map[string][]string)
+	for _, channel := range sorted {
+		if _, ok := listed[channel.ID]; ok || channel.IsThread() {
+			continue
+		}
+		switch {
+		case channel.Type == discordgo.ChannelTypeGuildCategory:
+			unlistedCategories = append(unlistedCategories, channel.ID)
+		case channel.ParentID == "":
+			unlistedTop = append(unlistedTop, channel.ID)
+		default:
+			unlistedInCategory[channel.ParentID] = append(unlistedInCategory[channel.ParentID], channel.ID)
+		}
+	}
+
+	updates := make([]channelPositionUpdate, 0)
+
+	for i, channelID := range append(append([]string{}, layout.channelIDs...), unlistedTop...) {
+		updates = append(updates, channelPositionUpdate{ID: channelID, Position: i})
+	}
+
+	categoryIDs := make([]string, 0, len(layout.categories)+len(unlistedCategories))
+	for _, category := range layout.categories {
+		categoryIDs = append(categoryIDs, category.id)
+	}
+	for i, categoryID := range append(categoryIDs, unlistedCategories...) {
+		updates = append(updates, channelPositionUpdate{ID: categoryID, Position: i})
+	}
+
+	for _, category := range layout.categories {
+		parentID := category.id
+		for j, channelID := range category.channelIDs {
+			update := channelPositionUpdate{ID: channelID, Position: j, ParentID: &parentID}
+			if !category.lockPermissions.IsNull() && !category.lockPermissions.IsUnknown() {
+				lock := category.lockPermissions.ValueBool()
+				update.LockPermissions = &lock
+			}
+			updates = append(updates, update)
+		}
+		for j, channelID := range unlistedInCategory[category.id] {
+			updates = append(updates, channelPositionUpdate{ID: channelID, Position: len(category.channelIDs) + j, ParentID: &parentID})
+		}
+	}
+
+	return updates
+}
+
+// channelLayoutPlacements maps every channel in the layout to a readable description of where it is placed.
+func channelLayoutPlacements(layout channelLayout, name func(string) string) map[string]string {
+	placements := make(map[string]string)
+
+	for i, channelID := range layout.channelIDs {
+		placements[channelID] = fmt.Sprintf("top level position %d", i)
+	}
+
+	for i, category := range layout.categories {
+		placements[category.id] = fmt.Sprintf("category position %d", i)
+		for j, channelID := range category.channelIDs {
+			placements[channelID] = fmt.Sprintf("%s position %d", name(category.id), j)
+		}
+	}
+
+	return placements
+}
+
+// describeChannelMoves returns one line per channel whose placement differs between two layouts.
+func describeChannelMoves(from, to channelLayout, name func(string) string) []string {
+	before := channelLayoutPlacements(from, name)
+	after := channelLayoutPlacements(to, name)
+
+	ids := make([]string, 0, len(after))
+	for id := range after {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	moves := make([]string, 0)
+	for _, id := range ids {
+		old, ok := before[id]
+		if !ok {
+			moves = append(moves, fmt.Sprintf("%s: placed at %s", name(id), after[id]))
+			continue
+		}
+		if old != after[id] {
+			moves = append(moves, fmt.Sprintf("%s: %s → %s", name(id), old, after[id]))
+		}
+	}
+
+	return moves
+}
+
+// describeRenumberedChannels returns one line per channel that is not listed in layout but whose position
+// changes when the layout is applied, because it is renumbered after its listed siblings.
+func describeRenumberedChannels(layout channelLayout, channels []*discordgo.Channel, name func(string) string) []string {
+	placements := channelLayoutPlacements(layout, name)
+	positions := make(map[string]int, len(channels))
+	for _, channel := range channels {
+		positions[channel.ID] = channel.Position
+	}
+
+	lines := make([]string, 0)
+	for _, update := range channelLayoutPositions(layout, channels) {
+		if _, listed := placements[update.ID]; listed {
+			continue
+		}
+		if old, ok := positions[update.ID]; ok && old != update.Position {
+			lines = append(lines, fmt.Sprintf("%s: renumbered from position %d to %d", name(update.ID), old, update.Position))
+		}
+	}
+
+	return lines
+}
+
+// readChannelLayout builds the actual layout of the guild's channels. If declared is nil, every category and
+// channel is included; otherwise only the declared categories and channels are, in their actual order.
+func readChannelLayout(channels []*discordgo.Channel, declared *channelLayout) channelLayout {
+	declaredCategories := make(map[string]types.Bool)
+	declaredChannels := make(map[string]struct{})
+	if declared != nil {
+		for _, channelID := range declared.channelIDs {
+			declaredChannels[channelID] = struct{}{}
+		}
+		for _, category := range declared.categories {
+			declaredCategories[category.id] = category.lockPermissions
+			for _, channelID := range category.channelIDs {
+				declaredChannels[channelID] = struct{}{}
+			}
+		}
+	}
+
+	sorted := make([]*discordgo.Channel, len(channels))
+	copy(sorted, channels)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Position != sorted[j].Position {
+			return sorted[i].Position < sorted[j].Position
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	layout := channelLayout{channelIDs: make([]string, 0), categories: make([]channelLayoutCategory, 0)}
+	categoryIndex := make(map[string]int)
+
+	for _, channel := range sorted {
+		if channel.Type != discordgo.ChannelTypeGuildCategory {
+			continue
+		}
+		lock, ok := declaredCategories[channel.ID]
+		if declared != nil && !ok {
+			continue
+		}
+		if declared == nil {
+			lock = types.BoolNull()
+		}
+		categoryIndex[channel.ID] = len(layout.categories)
+		layout.categories = append(layout.categories, channelLayoutCategory{id: channel.ID, channelIDs: make([]string, 0), lockPermissions: lock})
+	}
+
+	for _, channel := range sorted {
+		if channel.Type == discordgo.ChannelTypeGuildCategory || channel.IsThread() {
+			continue
+		}
+		if _, ok := declaredChannels[channel.ID]; declared != nil && !ok {
+			continue
+		}
+
+		if channel.ParentID == "" {
+			layout.channelIDs = append(layout.channelIDs, channel.ID)
+			continue
+		}
+
+		// Channels moved into a category that is not part of the layout drop out of it
+		if idx, ok := categoryIndex[channel.ParentID]; ok {
+			layout.categories[idx].channelIDs = append(layout.categories[idx].channelIDs, channel.ID)
+		}
+	}
+
+	return layout
+}
+
+// validateChannelLayout checks that the layout only references existing channels of the right type, each once.
+func validateChannelLayout(layout channelLayout, channels []*discordgo.Channel) error {
+	byID := make(map[string]*discordgo.Channel, len(channels))
+	for _, channel := range channels {
+		byID[channel.ID] = channel
+	}
+
+	seen := make(map[string]struct{})
+	use := func(id string) error {
+		if _, dup := seen[id]; dup {
+			return fmt.Errorf("channel %s is listed more than once", id)
+		}
+		seen[id] = struct{}{}
+		if _, ok := byID[id]; !ok {
+			return fmt.Errorf("channel %s does not exist in the guild", id)
+		}
+		return nil
+	}
+
+	for _, category := range layout.categories {
+		if err := use(category.id); err != nil {
+			return err
+		}
+		if byID[category.id].Type != discordgo.ChannelTypeGuildCategory {
+			return fmt.Errorf("channel %s is listed as a category but is not a category channel", category.id)
+		}
+	}
+
+	channelIDs := append([]string{}, layout.channelIDs...)
+	for _, category := range layout.categories {
+		channelIDs = append(channelIDs, category.channelIDs...)
+	}
+
+	for _, channelID := range channelIDs {
+		if err := use(channelID); err != nil {
+			return err
+		}
+		if byID[channelID].Type == discordgo.ChannelTypeGuildCategory {
+			return fmt.Errorf("category %s cannot be placed inside another category or listed as a channel", channelID)
+		}
+	}
+
+	return nil
+}
+
+// channelLayoutFromModel converts the Terraform model into a layout. The second return value is false
+// when part of the layout is not known yet.
+func channelLayoutFromModel(ctx context.Context, data channelOrderResourceModel) (channelLayout, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	layout := channelLayout{channelIDs: make([]string, 0), categories: make([]channelLayoutCategory, 0)}
+
+	if data.ChannelIDs.IsUnknown() || data.Categories.IsUnknown() {
+		return layout, false, diags
+	}
+
+	if !data.ChannelIDs.IsNull() {
+		diags.Append(data.ChannelIDs.ElementsAs(ctx, &layout.channelIDs, false)...)
+	}
+
+	if !data.Categories.IsNull() {
+		var categories []channelOrderCategoryModel
+		diags.Append(data.Categories.ElementsAs(ctx, &categories, false)...)
+		for _, category := range categories {
+			if category.CategoryID.IsUnknown() || category.ChannelIDs.IsUnknown() {
+				return layout, false, diags
+			}
+			channelIDs := make([]string, 0)
+			if !category.ChannelIDs.IsNull() {
+				diags.Append(category.ChannelIDs.ElementsAs(ctx, &channelIDs, false)...)
+			}
+			layout.categories = append(layout.categories, channelLayoutCategory{
+				id:              category.CategoryID.ValueString(),
+				channelIDs:      channelIDs,
+				lockPermissions: category.LockPermissions,
+			})
+		}
+	}
+
+	return layout, true, diags
+}
+
+// channelLayoutToModel stores a layout in the Terraform model.
+func channelLayoutToModel(ctx context.Context, layout channelLayout, data *channelOrderResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	channelIDs, d := types.ListValueFrom(ctx, types.StringType, layout.channelIDs)
+	diags.Append(d...)
+
+	categoryObjects := make([]attr.Value, 0, len(layout.categories))
+	for _, category := range layout.categories {
+		categoryChannelIDs, d := types.ListValueFrom(ctx, types.StringType, category.channelIDs)
+		diags.Append(d...)
+
+		obj, d := types.ObjectValue(channelOrderCategoryAttrTypes, map[string]attr.Value{
+			"category_id":      types.StringValue(category.id),
+			"channel_ids":      categoryChannelIDs,
+			"lock_permissions": category.lockPermissions,
+		})
+		diags.Append(d...)
+		categoryObjects = append(categoryObjects, obj)
+	}
+
+	categories, d := types.ListValue(types.ObjectType{AttrTypes: channelOrderCategoryAttrTypes}, categoryObjects)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// Keep an omitted top-level channel list null instead of an empty list
+	if len(layout.channelIDs) > 0 || !data.ChannelIDs.IsNull() {
+		data.ChannelIDs = channelIDs
+	}
+	data.Categories = categories
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *channelOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_order"
+}
+
+// Schema defines the schema for the resource.
+func (r *channelOrderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the channel layout of a Discord guild (server): the order of categories, and the order of the channels inside each category. " +
+			"The whole layout is applied in a single reorder request, so sibling channels are not renumbered one at a time. " +
+			"Categories and channels that are not listed stay in their category and keep their relative order, after the listed ones.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the channel order (same as guild_id).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild (server) whose channels are ordered. Changing this forces a new resource. Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"channel_ids": schema.ListAttribute{
+				Description: "The IDs of the channels that are not in any category, in order. Discord shows these above all categories.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  snowflakeListValidators(),
+			},
+			"categories": schema.ListNestedAttribute{
+				Description: "The categories in order, each with the channels it contains in order.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"category_id": schema.StringAttribute{
+							Description: "The ID of the category channel.",
+							Required:    true,
+							Validators: []validator.String{
+								snowflakeValidator(),
+							},
+						},
+						"channel_ids": schema.ListAttribute{
+							Description: "The IDs of the channels inside the category, in order. Channels listed here are moved into the category if needed.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators:  snowflakeListValidators(),
+						},
+						"lock_permissions": schema.BoolAttribute{
+							Description: "Whether to sync the permission overwrites of the channels with the category when they are placed.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up the resource with the provider's configured client.
+func (r *channelOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.defaultGuildID = providerData.guildID
+}
+
+// ModifyPlan reports which channels the planned layout moves or renumbers, using channel names where available.
+// On update the layout is compared with the prior state; on create, with the guild's current layout.
+func (r *channelOrderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanGuildID(ctx, r.defaultGuildID, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to report on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan channelOrderResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, known, diags := channelLayoutFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if !known || plan.GuildID.IsUnknown() || resp.Diagnostics.HasError() {
+		return
+	}
+	guildID := plan.GuildID.ValueString()
+
+	var channels []*discordgo.Channel
+	if r.client != nil {
+		channels, _ = r.client.GuildChannels(guildID, discordgo.WithContext(ctx))
+	}
+
+	var current channelLayout
+	if req.State.Raw.IsNull() {
+		// On create the listed channels move from wherever they are now, which is only known from the guild
+		if channels == nil {
+			return
+		}
+		current = readChannelLayout(channels, &planned)
+	} else {
+		var state channelOrderResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		current, _, diags = channelLayoutFromModel(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	names := make(map[string]string)
+	for _, channel := range channels {
+		names[channel.ID] = channel.Name
+	}
+	name := func(id string) string {
+		if n, ok := names[id]; ok {
+			return fmt.Sprintf("%s (%s)", n, id)
+		}
+		return id
+	}
+
+	moves := describeChannelMoves(current, planned, name)
+	moves = append(moves, describeRenumberedChannels(planned, channels, name)...)
+	if len(moves) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Channels Will Be Moved",
+		fmt.Sprintf("Applying this plan will move %d channel(s) in guild %s:\n%s",
+			len(moves), guildID, strings.Join(moves, "\n")),
+	)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *channelOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data channelOrderResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_channel_order", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logResourceCreated(ctx, data.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *channelOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data channelOrderResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_channel_order", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	guildID := data.GuildID.ValueString()
+	if guildID == "" {
+		resp.Diagnostics.AddError(
+			"Missing Guild ID",
+			"The guild ID is missing from the state.",
+		)
+		return
+	}
+
+	channels, err := r.client.GuildChannels(guildID, discordgo.WithContext(ctx))
+	if err != nil {
+		if isDiscordNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Guild Not Found",
+				fmt.Sprintf("Guild %s was not found, so its channel order is no longer managed. Removing from state.", guildID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Fetching Guild Channels",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch channels from guild %s", guildID), err),
+		)
+		return
+	}
+
+	// After import nothing is declared yet, so the complete layout is read
+	var declared *channelLayout
+	if !data.Categories.IsNull() {
+		layout, _, diags := channelLayoutFromModel(ctx, data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		declared = &layout
+	}
+
+	resp.Diagnostics.Append(channelLayoutToModel(ctx, readChannelLayout(channels, declared), &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(guildID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *channelOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data channelOrderResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_channel_order", "update", data.ID)
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from Terraform state. The channels keep their current layout.
+func (r *channelOrderResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Channel order cannot be "deleted"; removing the resource simply stops managing it
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *channelOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_channel_order", "import", types.StringValue(req.ID))
+
+	// The import ID is the guild ID; Read populates the complete current layout
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"The import ID must be the guild ID.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), types.StringValue(req.ID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+}
+
+// apply moves the guild's channels into the declared layout with a single Modify Guild Channel Positions request.
+func (r *channelOrderResource) apply(ctx context.Context, data *channelOrderResourceModel, diags *diag.Diagnostics) {
+	guildID := data.GuildID.ValueString()
+	if guildID == "" {
+		diags.AddError(
+			"Missing Guild ID",
+			"The guild_id attribute is required.",
+		)
+		return
+	}
+
+	layout, _, d := channelLayoutFromModel(ctx, *data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	channels, err := r.client.GuildChannels(guildID, discordgo.WithContext(ctx))
+	if err != nil {
+		diags.AddError(
+			"Error Fetching Guild Channels",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch channels from guild %s", guildID), err),
+		)
+		return
+	}
+
+	if err := validateChannelLayout(layout, channels); err != nil {
+		diags.AddError(
+			"Invalid Channel Layout",
+			fmt.Sprintf("Unable to apply the channel layout of guild %s: %s", guildID, err.Error()),
+		)
+		return
+	}
+
+	endpoint := discordgo.EndpointGuildChannels(guildID)
+	_, err = r.client.RequestWithBucketID("PATCH", endpoint, channelLayoutPositions(layout, channels), endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		diags.AddError(
+			"Error Reordering Channels",
+			discordErrorDetail(fmt.Sprintf("Unable to reorder channels in guild %s", guildID), err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(guildID)
+}
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tfstack/terraform-provider-discord/internal/fakediscord"
+)
+
+func TestChannelOrderResource_Metadata(t *testing.T) {
+	r := NewChannelOrderResource()
+	req := resource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_channel_order", resp.TypeName)
+}
+
+func TestChannelLayoutPositions(t *testing.T) {
+	channels := []*discordgo.Channel{
+		{ID: "rules", Type: discordgo.ChannelTypeGuildText, Position: 1},
+		{ID: "welcome", Type: discordgo.ChannelTypeGuildText, Position: 0},
+		{ID: "info", Type: discordgo.ChannelTypeGuildCategory, Position: 0},
+		{ID: "chat", Type: discordgo.ChannelTypeGuildCategory, Position: 1},
+		{ID: "archive", Type: discordgo.ChannelTypeGuildCategory, Position: 2},
+		{ID: "news", Type: discordgo.ChannelTypeGuildText, ParentID: "info", Position: 1},
+		{ID: "events", Type: discordgo.ChannelTypeGuildText, ParentID: "info", Position: 0},
+		{ID: "general", Type: discordgo.ChannelTypeGuildText, ParentID: "chat", Position: 0},
+		{ID: "voice", Type: discordgo.ChannelTypeGuildVoice, ParentID: "chat", Position: 1},
+		{ID: "old", Type: discordgo.ChannelTypeGuildText, ParentID: "archive", Position: 0},
+	}
+	layout := channelLayout{
+		channelIDs: []string{"rules"},
+		categories: []channelLayoutCategory{
+			{id: "chat", channelIDs: []string{"general", "voice"}, lockPermissions: types.BoolValue(true)},
+			{id: "info", channelIDs: []string{"news"}, lockPermissions: types.BoolNull()},
+		},
+	}
+
+	updates := channelLayoutPositions(layout, channels)
+
+	byID := make(map[string]channelPositionUpdate, len(updates))
+	for _, update := range updates {
+		byID[update.ID] = update
+	}
+
+	assert.Equal(t, 0, byID["rules"].Position)
+	assert.Nil(t, byID["rules"].ParentID)
+
+	assert.Equal(t, 0, byID["chat"].Position)
+	assert.Equal(t, 1, byID["info"].Position)
+	assert.Nil(t, byID["chat"].ParentID)
+
+	require.NotNil(t, byID["news"].ParentID)
+	assert.Equal(t, "info", *byID["news"].ParentID)
+	assert.Nil(t, byID["news"].LockPermissions)
+
+	assert.Equal(t, 1, byID["voice"].Position)
+	require.NotNil(t, byID["voice"].ParentID)
+	assert.Equal(t, "chat", *byID["voice"].ParentID)
+	require.NotNil(t, byID["voice"].LockPermissions)
+	assert.True(t, *byID["voice"].LockPermissions)
+
+	// Unlisted siblings are renumbered after the listed ones instead of colliding with them
+	assert.Equal(t, 1, byID["welcome"].Position)
+	assert.Nil(t, byID["welcome"].ParentID)
+	assert.Equal(t, 2, byID["archive"].Position)
+	assert.Equal(t, 1, byID["events"].Position)
+	require.NotNil(t, byID["events"].ParentID)
+	assert.Equal(t, "info", *byID["events"].ParentID)
+	assert.Nil(t, byID["events"].LockPermissions)
+
+	// Channels in unlisted categories are left alone
+	_, ok := byID["old"]
+	assert.False(t, ok)
+	assert.Len(t, updates, 9)
+}
+
+func TestReadChannelLayout(t *testing.T) {
+	channels := []*discordgo.Channel{
+		{ID: "chat", Type: discordgo.ChannelTypeGuildCategory, Position: 0},
+		{ID: "info", Type: discordgo.ChannelTypeGuildCategory, Position: 1},
+		{ID: "general", Type: discordgo.ChannelTypeGuildText, ParentID: "chat", Position: 1},
+		{ID: "voice", Type: discordgo.ChannelTypeGuildVoice, ParentID: "chat", Position: 0},
+		{ID: "news", Type: discordgo.ChannelTypeGuildText, ParentID: "info", Position: 0},
+		{ID: "rules", Type: discordgo.ChannelTypeGuildText, Position: 0},
+	}
+
+	t.Run("everything after import", func(t *testing.T) {
+		layout := readChannelLayout(channels, nil)
+		assert.Equal(t, []string{"rules"}, layout.channelIDs)
+		require.Len(t, layout.categories, 2)
+		assert.Equal(t, "chat", layout.categories[0].id)
+		assert.Equal(t, []string{"voice", "general"}, layout.categories[0].channelIDs)
+		assert.Equal(t, "info", layout.categories[1].id)
+		assert.Equal(t, []string{"news"}, layout.categories[1].channelIDs)
+	})
+
+	t.Run("only declared channels", func(t *testing.T) {
+		declared := channelLayout{
+			categories: []channelLayoutCategory{
+				{id: "info", channelIDs: []string{"news", "general"}, lockPermissions: types.BoolValue(true)},
+			},
+		}
+		layout := readChannelLayout(channels, &declared)
+		assert.Empty(t, layout.channelIDs)
+		require.Len(t, layout.categories, 1)
+		assert.Equal(t, []string{"news"}, layout.categories[0].channelIDs)
+		assert.True(t, layout.categories[0].lockPermissions.ValueBool())
+	})
+}
+
+func TestValidateChannelLayout(t *testing.T) {
+	channels := []*discordgo.Channel{
+		{ID: "chat", Type: discordgo.ChannelTypeGuildCategory},
+		{ID: "general", Type: discordgo.ChannelTypeGuildText, ParentID: "chat"},
+	}
+
+	assert.NoError(t, validateChannelLayout(channelLayout{
+		categories: []channelLayoutCategory{{id: "chat", channelIDs: []string{"general"}}},
+	}, channels))
+
+	assert.ErrorContains(t, validateChannelLayout(channelLayout{
+		categories: []channelLayoutCategory{{id: "general"}},
+	}, channels), "not a category")
+
+	assert.ErrorContains(t, validateChannelLayout(channelLayout{
+		channelIDs: []string{"general"},
+		categories: []channelLayoutCategory{{id: "chat", channelIDs: []string{"general"}}},
+	}, channels), "more than once")
+
+	assert.ErrorContains(t, validateChannelLayout(channelLayout{
+		channelIDs: []string{"missing"},
+	}, channels), "does not exist")
+}
+
+func TestDescribeChannelMoves(t *testing.T) {
+	from := channelLayout{
+		categories: []channelLayoutCategory{
+			{id: "chat", channelIDs: []string{"general", "voice"}},
+		},
+	}
+	to := channelLayout{
+		channelIDs: []string{"voice"},
+		categories: []channelLayoutCategory{
+			{id: "chat", channelIDs: []string{"general"}},
+		},
+	}
+
+	moves := describeChannelMoves(from, to, func(id string) string { return id })
+	assert.Equal(t, []string{"voice: chat position 1 → top level position 0"}, moves)
+}
+
+func TestAccChannelOrderResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	info := s.AddChannel(guild.ID, "Info", discordgo.ChannelTypeGuildCategory, "")
+	chat := s.AddChannel(guild.ID, "Chat", discordgo.ChannelTypeGuildCategory, "")
+	rules := s.AddChannel(guild.ID, "rules", discordgo.ChannelTypeGuildText, "")
+	general := s.AddChannel(guild.ID, "general", discordgo.ChannelTypeGuildText, "")
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + testAccChannelOrderResourceConfig(guild.ID, info.ID, rules.ID, chat.ID, general.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_channel_order.test", "id", guild.ID),
+					testAccCheckChannelParent(s, rules.ID, info.ID),
+					testAccCheckChannelParent(s, general.ID, chat.ID),
+				),
+			},
+			{
+				// Swapping the categories and their channels is applied in place
+				Config: providerConfig + testAccChannelOrderResourceConfig(guild.ID, chat.ID, rules.ID, info.ID, general.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelParent(s, rules.ID, chat.ID),
+					testAccCheckChannelParent(s, general.ID, info.ID),
+					func(*terraform.State) error {
+						first, _ := s.Channel(chat.ID)
+						second, _ := s.Channel(info.ID)
+						if first.Position >= second.Position {
+							return fmt.Errorf("category %s is not above category %s", chat.ID, info.ID)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// A channel moved outside Terraform is moved back
+				PreConfig: func() { s.MoveChannel(rules.ID, "") },
+				Config:    providerConfig + testAccChannelOrderResourceConfig(guild.ID, chat.ID, rules.ID, info.ID, general.ID),
+				Check:     testAccCheckChannelParent(s, rules.ID, chat.ID),
+			},
+		},
+	})
+}
+
+func TestChannelOrderResource_ModifyPlanReportsMovesOnCreate(t *testing.T) {
+	s, _ := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	info := s.AddChannel(guild.ID, "Info", discordgo.ChannelTypeGuildCategory, "")
+	rules := s.AddChannel(guild.ID, "rules", discordgo.ChannelTypeGuildText, "")
+	lobby := s.AddChannel(guild.ID, "lobby", discordgo.ChannelTypeGuildText, info.ID)
+
+	r := &channelOrderResource{client: testFakeClient(t, s)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(t.Context(), resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	model := channelOrderResourceModel{
+		ID:         types.StringUnknown(),
+		GuildID:    types.StringValue(guild.ID),
+		ChannelIDs: types.ListNull(types.StringType),
+		Timeouts:   timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType, "read": types.StringType, "update": types.StringType, "delete": types.StringType})},
+	}
+	layout := channelLayout{categories: []channelLayoutCategory{{id: info.ID, channelIDs: []string{rules.ID}}}}
+	require.False(t, channelLayoutToModel(t.Context(), layout, &model).HasError())
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(t.Context()), nil)}
+	require.False(t, plan.Set(t.Context(), &model).HasError())
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   plan,
+		State:  tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(t.Context(), req, resp)
+
+	// Adopting the guild moves rules into Info, ahead of lobby, which is renumbered after it
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	require.Equal(t, 1, resp.Diagnostics.WarningsCount())
+	detail := resp.Diagnostics.Warnings()[0].Detail()
+	assert.Contains(t, detail, fmt.Sprintf("rules (%s): top level position 0 → Info (%s) position 0", rules.ID, info.ID))
+	assert.Contains(t, detail, fmt.Sprintf("lobby (%s): renumbered from position", lobby.ID))
+}
+
+func testAccCheckChannelParent(s *fakediscord.Server, channelID, parentID string) tfresource.TestCheckFunc {
+	return func(*terraform.State) error {
+		channel, ok := s.Channel(channelID)
+		if !ok {
+			return fmt.Errorf("channel %s not found", channelID)
+		}
+		if channel.ParentID != parentID {
+			return fmt.Errorf("channel %s is in category %q, want %q", channelID, channel.ParentID, parentID)
+		}
+		return nil
+	}
+}
+
+func testAccChannelOrderResourceConfig(guildID, firstCategoryID, firstChannelID, secondCategoryID, secondChannelID string) string {
+	return fmt.Sprintf(`
+resource "discord_channel_order" "test" {
+  guild_id = %q
+
+  categories = [
+    {
+      category_id = %q
+      channel_ids = [%q]
+    },
+    {
+      category_id = %q
+      channel_ids = [%q]
+    },
+  ]
+}
+`, guildID, firstCategoryID, firstChannelID, secondCategoryID, secondChannelID)
+}
@@ -5,8 +5,14 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -22,12 +28,13 @@ type channelPermissionResource struct {
 
 // channelPermissionResourceModel describes the resource data model.
 type channelPermissionResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	ChannelID   types.String `tfsdk:"channel_id"`
-	Type        types.String `tfsdk:"type"`
-	OverwriteID types.String `tfsdk:"overwrite_id"`
-	Allow       types.Int64  `tfsdk:"allow"`
-	Deny        types.Int64  `tfsdk:"deny"`
+	ID          types.String   `tfsdk:"id"`
+	ChannelID   types.String   `tfsdk:"channel_id"`
+	Type        types.String   `tfsdk:"type"`
+	OverwriteID types.String   `tfsdk:"overwrite_id"`
+	Allow       types.Int64    `tfsdk:"allow"`
+	Deny        types.Int64    `tfsdk:"deny"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewChannelPermissionResource is a helper function to simplify testing.
@@ -41,35 +48,70 @@ func (r *channelPermissionResource) Metadata(_ context.Context, req resource.Met
 }
 
 // Schema defines the schema for the resource.
-func (r *channelPermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *channelPermissionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates and manages Discord channel permission overwrites. Permission overwrites allow you to grant or deny specific permissions for a role or member in a channel.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "A unique identifier for this permission overwrite, composed of channel_id:overwrite_id.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"channel_id": schema.StringAttribute{
-				Description: "The ID of the channel to set permissions for.",
+				Description: "The ID of the channel to set permissions for. Changing this forces a new resource.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"type": schema.StringAttribute{
-				Description: "The type of permission overwrite. Valid values: \"role\" (for a role) or \"member\" (for a user/member).",
+				Description: "The type of permission overwrite. Valid values: \"role\" (for a role) or \"member\" (for a user/member). Changing this forces a new resource.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("role", "member"),
+				},
 			},
 			"overwrite_id": schema.StringAttribute{
-				Description: "The ID of the role or member to set permissions for. Must match the type (role ID for type=\"role\", user ID for type=\"member\").",
+				Description: "The ID of the role or member to set permissions for. Must match the type (role ID for type=\"role\", user ID for type=\"member\"). Changing this forces a new resource.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"allow": schema.Int64Attribute{
 				Description: "The permission bits to allow. Use permission constants or calculate from Discord permission flags.",
 				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 			},
 			"deny": schema.Int64Attribute{
 				Description: "The permission bits to deny. Use permission constants or calculate from Discord permission flags. Defaults to 0 if not specified.",
 				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -79,16 +121,16 @@ func (r *channelPermissionResource) Configure(_ context.Context, req resource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -101,6 +143,17 @@ func (r *channelPermissionResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_channel_permission", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -188,11 +241,11 @@ func (r *channelPermissionResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Get current channel to preserve existing overwrites
-	channel, err := r.client.Channel(channelID)
+	channel, err := r.client.Channel(channelID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Channel",
-			fmt.Sprintf("Unable to fetch channel %s: %s", channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch channel %s", channelID), err),
 		)
 		return
 	}
@@ -215,11 +268,11 @@ func (r *channelPermissionResource) Create(ctx context.Context, req resource.Cre
 		PermissionOverwrites: overwrites,
 	}
 
-	updatedChannel, err := r.client.ChannelEditComplex(channelID, edit)
+	updatedChannel, err := r.client.ChannelEditComplex(channelID, edit, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Setting Channel Permission",
-			fmt.Sprintf("Unable to set permission for %s %s on channel %s: %s", typeStr, overwriteID, channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to set permission for %s %s on channel %s", typeStr, overwriteID, channelID), err),
 		)
 		return
 	}
@@ -236,7 +289,7 @@ func (r *channelPermissionResource) Create(ctx context.Context, req resource.Cre
 	// If not found in immediate response, fetch channel again to verify
 	if finalOverwrite == nil {
 		// Fetch the channel again to get the latest state
-		refreshedChannel, err := r.client.Channel(channelID)
+		refreshedChannel, err := r.client.Channel(channelID, discordgo.WithContext(ctx))
 		if err == nil {
 			for _, ow := range refreshedChannel.PermissionOverwrites {
 				if ow.ID == overwriteID && ow.Type == overwriteType {
@@ -264,6 +317,8 @@ func (r *channelPermissionResource) Create(ctx context.Context, req resource.Cre
 	data.Allow = types.Int64Value(finalOverwrite.Allow)
 	data.Deny = types.Int64Value(finalOverwrite.Deny)
 
+	logResourceCreated(ctx, data.ID)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -278,6 +333,17 @@ func (r *channelPermissionResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_channel_permission", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -315,11 +381,19 @@ func (r *channelPermissionResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Fetch the channel
-	channel, err := r.client.Channel(channelID)
+	channel, err := r.client.Channel(channelID, discordgo.WithContext(ctx))
 	if err != nil {
+		if isDiscordNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Channel Not Found",
+				fmt.Sprintf("Channel %s was not found, so its permission overwrite no longer exists. Removing from state.", channelID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Fetching Channel",
-			fmt.Sprintf("Unable to fetch channel %s: %s", channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch channel %s", channelID), err),
 		)
 		return
 	}
@@ -335,6 +409,10 @@ func (r *channelPermissionResource) Read(ctx context.Context, req resource.ReadR
 
 	if overwrite == nil {
 		// Permission overwrite doesn't exist - mark resource for deletion
+		resp.Diagnostics.AddWarning(
+			"Permission Overwrite Not Found",
+			fmt.Sprintf("The permission overwrite for %s %s was not found on channel %s. It may have been deleted outside of Terraform. Removing from state.", typeStr, overwriteID, channelID),
+		)
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -362,6 +440,17 @@ func (r *channelPermissionResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_channel_permission", "update", state.ID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -401,11 +490,11 @@ func (r *channelPermissionResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Get current channel to preserve existing overwrites
-	channel, err := r.client.Channel(channelID)
+	channel, err := r.client.Channel(channelID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Channel",
-			fmt.Sprintf("Unable to fetch channel %s: %s", channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch channel %s", channelID), err),
 		)
 		return
 	}
@@ -434,11 +523,11 @@ func (r *channelPermissionResource) Update(ctx context.Context, req resource.Upd
 		PermissionOverwrites: overwrites,
 	}
 
-	updatedChannel, err := r.client.ChannelEditComplex(channelID, edit)
+	updatedChannel, err := r.client.ChannelEditComplex(channelID, edit, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Channel Permission",
-			fmt.Sprintf("Unable to update permission for %s %s on channel %s: %s", typeStr, overwriteID, channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to update permission for %s %s on channel %s", typeStr, overwriteID, channelID), err),
 		)
 		return
 	}
@@ -455,7 +544,7 @@ func (r *channelPermissionResource) Update(ctx context.Context, req resource.Upd
 	// If not found in immediate response, fetch channel again to verify
 	if finalOverwrite == nil {
 		// Fetch the channel again to get the latest state
-		refreshedChannel, err := r.client.Channel(channelID)
+		refreshedChannel, err := r.client.Channel(channelID, discordgo.WithContext(ctx))
 		if err == nil {
 			for _, ow := range refreshedChannel.PermissionOverwrites {
 				if ow.ID == overwriteID && ow.Type == overwriteType {
@@ -503,6 +592,17 @@ func (r *channelPermissionResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_channel_permission", "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -529,11 +629,11 @@ func (r *channelPermissionResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	// Get current channel to remove the overwrite
-	channel, err := r.client.Channel(channelID)
+	channel, err := r.client.Channel(channelID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Channel",
-			fmt.Sprintf("Unable to fetch channel %s: %s", channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch channel %s", channelID), err),
 		)
 		return
 	}
@@ -551,11 +651,11 @@ func (r *channelPermissionResource) Delete(ctx context.Context, req resource.Del
 		PermissionOverwrites: overwrites,
 	}
 
-	_, err = r.client.ChannelEditComplex(channelID, edit)
+	_, err = r.client.ChannelEditComplex(channelID, edit, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Channel Permission",
-			fmt.Sprintf("Unable to delete permission for %s %s on channel %s: %s", typeStr, overwriteID, channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to delete permission for %s %s on channel %s", typeStr, overwriteID, channelID), err),
 		)
 		return
 	}
@@ -564,6 +664,8 @@ func (r *channelPermissionResource) Delete(ctx context.Context, req resource.Del
 // ImportState imports an existing resource into Terraform state.
 // Import ID format: channel_id:overwrite_id:type (e.g., "123456789012345678:987654321098765432:role").
 func (r *channelPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_channel_permission", "import", types.StringValue(req.ID))
+
 	// Parse the import ID: format is "channel_id:overwrite_id:type"
 	importID := req.ID
 
@@ -632,11 +734,11 @@ func (r *channelPermissionResource) ImportState(ctx context.Context, req resourc
 	}
 
 	// Fetch the channel to get the permission overwrite
-	channel, err := r.client.Channel(channelID)
+	channel, err := r.client.Channel(channelID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Channel",
-			fmt.Sprintf("Unable to fetch channel %s: %s", channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch channel %s", channelID), err),
 		)
 		return
 	}
@@ -660,6 +762,7 @@ func (r *channelPermissionResource) ImportState(ctx context.Context, req resourc
 
 	// Create a model with the permission data
 	var data channelPermissionResourceModel
+	data.Timeouts = nullTimeouts()
 	data.ID = types.StringValue(fmt.Sprintf("%s:%s", channelID, overwriteID))
 	data.ChannelID = types.StringValue(channelID)
 	data.Type = types.StringValue(typeStr)
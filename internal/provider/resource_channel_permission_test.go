@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -57,8 +60,8 @@ func TestChannelPermissionResource_Configure(t *testing.T) {
 		errorContains string
 	}{
 		{
-			name:         "valid discordgo.Session",
-			providerData: &discordgo.Session{},
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
 			expectError:  false,
 		},
 		{
@@ -96,7 +99,65 @@ func TestChannelPermissionResource_Configure(t *testing.T) {
 	}
 }
 
-// Note: Tests for Create, Read, Update, and Delete methods that require Discord API calls
-// should be implemented as acceptance tests with TF_ACC=1 environment variable set.
-// These unit tests verify the schema, metadata, and configuration validation
-// without making API calls.
+func TestAccChannelPermissionResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	channel := s.AddChannel(guild.ID, "chat", discordgo.ChannelTypeGuildText, "")
+	role := s.AddRole(guild.ID, "Members")
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + testAccChannelPermissionResourceConfig(channel.ID, role.ID, discordgo.PermissionViewChannel, 0),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_channel_permission.test", "allow", fmt.Sprint(discordgo.PermissionViewChannel)),
+					tfresource.TestCheckResourceAttr("discord_channel_permission.test", "deny", "0"),
+				),
+			},
+			{
+				ResourceName:      "discord_channel_permission.test",
+				ImportState:       true,
+				ImportStateId:     channel.ID + ":" + role.ID + ":role",
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + testAccChannelPermissionResourceConfig(channel.ID, role.ID, discordgo.PermissionViewChannel, discordgo.PermissionSendMessages),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_channel_permission.test", "deny", fmt.Sprint(discordgo.PermissionSendMessages)),
+					func(*terraform.State) error {
+						got, _ := s.Channel(channel.ID)
+						if len(got.PermissionOverwrites) != 1 || got.PermissionOverwrites[0].Deny != discordgo.PermissionSendMessages {
+							return fmt.Errorf("overwrite was not updated: %+v", got.PermissionOverwrites)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// An overwrite removed outside Terraform is removed from state and created again
+				PreConfig: func() { s.DeleteOverwrite(channel.ID, role.ID) },
+				Config:    providerConfig + testAccChannelPermissionResourceConfig(channel.ID, role.ID, discordgo.PermissionViewChannel, discordgo.PermissionSendMessages),
+				Check: func(*terraform.State) error {
+					got, _ := s.Channel(channel.ID)
+					if len(got.PermissionOverwrites) != 1 {
+						return fmt.Errorf("overwrite was not recreated: %+v", got.PermissionOverwrites)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccChannelPermissionResourceConfig(channelID, roleID string, allow, deny int64) string {
+	return fmt.Sprintf(`
+resource "discord_channel_permission" "test" {
+  channel_id   = %q
+  type         = "role"
+  overwrite_id = %q
+  allow        = %d
+  deny         = %d
+}
+`, channelID, roleID, allow, deny)
+}
@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,7 +41,8 @@ func TestChannelResource_Schema(t *testing.T) {
 
 	guildIDAttr, ok := resp.Schema.Attributes["guild_id"]
 	assert.True(t, ok)
-	assert.True(t, guildIDAttr.IsRequired())
+	assert.True(t, guildIDAttr.IsOptional(), "guild_id defaults to the provider's guild_id")
+	assert.True(t, guildIDAttr.IsComputed())
 
 	// Check optional attributes
 	optionalAttrs := []string{"type", "category_id", "position"}
@@ -64,8 +69,8 @@ func TestChannelResource_Configure(t *testing.T) {
 		errorContains string
 	}{
 		{
-			name:         "valid discordgo.Session",
-			providerData: &discordgo.Session{},
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
 			expectError:  false,
 		},
 		{
@@ -192,7 +197,166 @@ func TestChannelTypeToString(t *testing.T) {
 	}
 }
 
-// Note: Tests for Create, Read, Update, and Delete methods that require Discord API calls
-// should be implemented as acceptance tests with TF_ACC=1 environment variable set.
-// These unit tests verify the schema, metadata, configuration validation, and helper functions
-// without making API calls.
+func TestAccChannelResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	general := s.AddChannel(guild.ID, "General", discordgo.ChannelTypeGuildCategory, "")
+	archive := s.AddChannel(guild.ID, "Archive", discordgo.ChannelTypeGuildCategory, "")
+
+	var channelID string
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + testAccChannelResourceConfig(guild.ID, "chat", general.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_channel.test", "name", "chat"),
+					tfresource.TestCheckResourceAttr("discord_channel.test", "type", "text"),
+					tfresource.TestCheckResourceAttr("discord_channel.test", "category_id", general.ID),
+					testAccCaptureAttr("discord_channel.test", "id", &channelID),
+				),
+			},
+			{
+				ResourceName:            "discord_channel.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"position"},
+			},
+			{
+				Config: providerConfig + testAccChannelResourceConfig(guild.ID, "old-chat", archive.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_channel.test", "name", "old-chat"),
+					tfresource.TestCheckResourceAttr("discord_channel.test", "category_id", archive.ID),
+					func(*terraform.State) error {
+						channel, ok := s.Channel(channelID)
+						if !ok || channel.ParentID != archive.ID {
+							return fmt.Errorf("channel %s was not moved in place", channelID)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// A channel deleted outside Terraform is removed from state and created again
+				PreConfig: func() { s.DeleteChannel(channelID) },
+				Config:    providerConfig + testAccChannelResourceConfig(guild.ID, "old-chat", archive.ID),
+				Check: func(state *terraform.State) error {
+					id, err := testAccAttr(state, "discord_channel.test", "id")
+					if err == nil && id == channelID {
+						return fmt.Errorf("channel was not recreated after it was deleted")
+					}
+					return err
+				},
+			},
+		},
+	})
+}
+
+func TestAccChannelResource_positionTrackedOnlyWhenConfigured(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	general := s.AddChannel(guild.ID, "General", discordgo.ChannelTypeGuildCategory, "")
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + testAccChannelResourceConfig(guild.ID, "chat", general.ID),
+				Check:  tfresource.TestCheckNoResourceAttr("discord_channel.test", "position"),
+			},
+			{
+				// An update without position leaves it unset rather than recording Discord's value
+				Config: providerConfig + testAccChannelResourceConfig(guild.ID, "chat-renamed", general.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_channel.test", "name", "chat-renamed"),
+					tfresource.TestCheckNoResourceAttr("discord_channel.test", "position"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "discord_channel" "test" {
+  guild_id    = %q
+  name        = "chat-renamed"
+  category_id = %q
+  position    = 2
+}
+`, guild.ID, general.ID),
+				Check: tfresource.TestCheckResourceAttr("discord_channel.test", "position", "2"),
+			},
+		},
+	})
+}
+
+func TestAccChannelResource_communityOnly(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "discord_channel" "test" {
+  guild_id = %q
+  name     = "help"
+  type     = "directory"
+}
+`, guild.ID),
+				ExpectError: regexp.MustCompile(`COMMUNITY`),
+			},
+		},
+	})
+}
+
+func TestAccChannelResource_invalidConfig(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				// Text channel names are rejected at plan time instead of being rewritten by Discord
+				Config: providerConfig + fmt.Sprintf(`
+resource "discord_channel" "test" {
+  guild_id = %q
+  name     = "General Chat"
+}
+`, guild.ID),
+				ExpectError: regexp.MustCompile(`"general-chat"`),
+				PlanOnly:    true,
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "discord_channel" "test" {
+  guild_id = %q
+  name     = "stage"
+  type     = "stage"
+}
+`, guild.ID),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+				PlanOnly:    true,
+			},
+			{
+				Config: providerConfig + `
+resource "discord_channel" "test" {
+  guild_id = "123"
+  name     = "chat"
+}
+`,
+				ExpectError: regexp.MustCompile(`must be a Discord ID`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+func testAccChannelResourceConfig(guildID, name, categoryID string) string {
+	return fmt.Sprintf(`
+resource "discord_channel" "test" {
+  guild_id    = %q
+  name        = %q
+  category_id = %q
+}
+`, guildID, name, categoryID)
+}
@@ -10,37 +10,46 @@ import (
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the resource type implements the required interfaces.
 var _ resource.Resource = &emojiResource{}
 var _ resource.ResourceWithConfigure = &emojiResource{}
+var _ resource.ResourceWithModifyPlan = &emojiResource{}
 var _ resource.ResourceWithImportState = &emojiResource{}
 
 // emojiResource defines the resource implementation.
 type emojiResource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // emojiResourceModel describes the resource data model.
 type emojiResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	GuildID       types.String `tfsdk:"guild_id"`
-	Name          types.String `tfsdk:"name"`
-	Image         types.String `tfsdk:"image"`
-	ImagePath     types.String `tfsdk:"image_path"`
-	ImageURL      types.String `tfsdk:"image_url"`
-	Roles         types.List   `tfsdk:"roles"`
-	Animated      types.Bool   `tfsdk:"animated"`
-	Managed       types.Bool   `tfsdk:"managed"`
-	RequireColons types.Bool   `tfsdk:"require_colons"`
-	Available     types.Bool   `tfsdk:"available"`
-	User          types.String `tfsdk:"user"`
+	ID            types.String   `tfsdk:"id"`
+	GuildID       types.String   `tfsdk:"guild_id"`
+	Name          types.String   `tfsdk:"name"`
+	Image         types.String   `tfsdk:"image"`
+	ImagePath     types.String   `tfsdk:"image_path"`
+	ImageURL      types.String   `tfsdk:"image_url"`
+	Roles         types.List     `tfsdk:"roles"`
+	Animated      types.Bool     `tfsdk:"animated"`
+	Managed       types.Bool     `tfsdk:"managed"`
+	RequireColons types.Bool     `tfsdk:"require_colons"`
+	Available     types.Bool     `tfsdk:"available"`
+	User          types.String   `tfsdk:"user"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewEmojiResource is a helper function to simplify testing.
@@ -54,21 +63,35 @@ func (r *emojiResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 // Schema defines the schema for the resource.
-func (r *emojiResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *emojiResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates and manages a Discord custom emoji in a guild (server).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of the emoji.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the guild (server) where the emoji will be created.",
-				Required:    true,
+				Description: "The ID of the guild (server) where the emoji will be created. Changing this forces a new resource. Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the emoji. Must be 2-32 characters and contain only alphanumeric characters and underscores.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emojiNameRegexp, "must be 2-32 characters and contain only letters, numbers and underscores"),
+				},
 			},
 			"image": schema.StringAttribute{
 				Description: "Base64-encoded image data for the emoji. Must be a valid PNG, JPG, or GIF image. Either image, image_path, or image_url must be provided.",
@@ -87,6 +110,7 @@ func (r *emojiResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "List of role IDs that can use this emoji. If empty, all roles can use it.",
 				ElementType: types.StringType,
 				Optional:    true,
+				Validators:  snowflakeListValidators(),
 			},
 			"animated": schema.BoolAttribute{
 				Description: "Whether the emoji is animated (read-only, determined by image format).",
@@ -107,8 +131,19 @@ func (r *emojiResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"user": schema.StringAttribute{
 				Description: "The ID of the user who created the emoji (read-only).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -118,16 +153,22 @@ func (r *emojiResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.defaultGuildID = providerData.guildID
+}
+
+// ModifyPlan fills in guild_id from the provider's default guild when it is not configured.
+func (r *emojiResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanGuildID(ctx, r.defaultGuildID, req, resp)
 }
 
 // readImageData reads image data from various sources (base64, file path, or URL).
@@ -207,6 +248,17 @@ func (r *emojiResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_emoji", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -273,11 +325,11 @@ func (r *emojiResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Create the emoji
-	emoji, err := r.client.GuildEmojiCreate(guildID, emojiParams)
+	emoji, err := r.client.GuildEmojiCreate(guildID, emojiParams, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Emoji",
-			fmt.Sprintf("Unable to create emoji %s in guild %s: %s", name, guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to create emoji %s in guild %s", name, guildID), err),
 		)
 		return
 	}
@@ -347,6 +399,8 @@ func (r *emojiResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.ImageURL = types.StringNull()
 	}
 
+	logResourceCreated(ctx, data.ID)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -361,6 +415,17 @@ func (r *emojiResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_emoji", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -382,14 +447,21 @@ func (r *emojiResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Fetch the emoji
-	emoji, err := r.client.GuildEmoji(guildID, emojiID)
+	emoji, err := r.client.GuildEmoji(guildID, emojiID, discordgo.WithContext(ctx))
 	if err != nil {
-		// If emoji doesn't exist, mark as removed
-		resp.Diagnostics.AddWarning(
-			"Emoji Not Found",
-			fmt.Sprintf("Emoji %s was not found in guild %s. It may have been deleted. Removing from state.", emojiID, guildID),
+		if isDiscordNotFound(err) {
+			// If emoji doesn't exist, mark as removed
+			resp.Diagnostics.AddWarning(
+				"Emoji Not Found",
+				fmt.Sprintf("Emoji %s was not found in guild %s. It may have been deleted. Removing from state.", emojiID, guildID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Fetching Emoji",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch emoji %s from guild %s", emojiID, guildID), err),
 		)
-		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -439,6 +511,17 @@ func (r *emojiResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_emoji", "update", state.ID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -502,11 +585,11 @@ func (r *emojiResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Update the emoji
-	emoji, err := r.client.GuildEmojiEdit(guildID, emojiID, emojiParams)
+	emoji, err := r.client.GuildEmojiEdit(guildID, emojiID, emojiParams, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Emoji",
-			fmt.Sprintf("Unable to update emoji %s in guild %s: %s", emojiID, guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to update emoji %s in guild %s", emojiID, guildID), err),
 		)
 		return
 	}
@@ -579,6 +662,17 @@ func (r *emojiResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_emoji", "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -600,11 +694,11 @@ func (r *emojiResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	// Delete the emoji
-	err := r.client.GuildEmojiDelete(guildID, emojiID)
+	err := r.client.GuildEmojiDelete(guildID, emojiID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Emoji",
-			fmt.Sprintf("Unable to delete emoji %s from guild %s: %s", emojiID, guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to delete emoji %s from guild %s", emojiID, guildID), err),
 		)
 		return
 	}
@@ -612,6 +706,8 @@ func (r *emojiResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // ImportState imports an existing resource into Terraform.
 func (r *emojiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_emoji", "import", types.StringValue(req.ID))
+
 	// Import format: guild_id:emoji_id
 	importID := req.ID
 	if importID == "" {
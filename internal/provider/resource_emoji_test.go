@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccEmojiImage is a 1x1 transparent PNG.
+const testAccEmojiImage = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII="
+
+func TestAccEmojiResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+
+	var emojiID string
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + testAccEmojiResourceConfig(guild.ID, "terraform"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_emoji.test", "name", "terraform"),
+					tfresource.TestCheckResourceAttr("discord_emoji.test", "animated", "false"),
+					testAccCaptureAttr("discord_emoji.test", "id", &emojiID),
+				),
+			},
+			{
+				ResourceName:            "discord_emoji.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportID("discord_emoji.test", "guild_id", "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image"},
+			},
+			{
+				Config: providerConfig + testAccEmojiResourceConfig(guild.ID, "terraform_logo"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_emoji.test", "name", "terraform_logo"),
+					tfresource.TestCheckResourceAttrPtr("discord_emoji.test", "id", &emojiID),
+				),
+			},
+			{
+				// An emoji deleted outside Terraform is removed from state and created again
+				PreConfig: func() { s.DeleteEmoji(guild.ID, emojiID) },
+				Config:    providerConfig + testAccEmojiResourceConfig(guild.ID, "terraform_logo"),
+				Check: func(state *terraform.State) error {
+					id, err := testAccAttr(state, "discord_emoji.test", "id")
+					if err == nil && id == emojiID {
+						return fmt.Errorf("emoji was not recreated after it was deleted")
+					}
+					return err
+				},
+			},
+		},
+	})
+}
+
+func testAccEmojiResourceConfig(guildID, name string) string {
+	return fmt.Sprintf(`
+resource "discord_emoji" "test" {
+  guild_id = %q
+  name     = %q
+  image    = %q
+}
+`, guildID, name, testAccEmojiImage)
+}
@@ -5,32 +5,41 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the resource type implements the required interfaces.
 var _ resource.Resource = &everyoneRoleResource{}
 var _ resource.ResourceWithConfigure = &everyoneRoleResource{}
+var _ resource.ResourceWithModifyPlan = &everyoneRoleResource{}
 
 // everyoneRoleResource defines the resource implementation.
 // Note: This resource does NOT implement ResourceWithImportState because
 // the @everyone role always exists and doesn't need importing.
 type everyoneRoleResource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // everyoneRoleResourceModel describes the resource data model.
 type everyoneRoleResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	GuildID     types.String `tfsdk:"guild_id"`
-	Color       types.Int64  `tfsdk:"color"`
-	Hoist       types.Bool   `tfsdk:"hoist"`
-	Mentionable types.Bool   `tfsdk:"mentionable"`
-	Permissions types.Int64  `tfsdk:"permissions"`
-	Position    types.Int64  `tfsdk:"position"`
-	Managed     types.Bool   `tfsdk:"managed"`
+	ID          types.String   `tfsdk:"id"`
+	GuildID     types.String   `tfsdk:"guild_id"`
+	Color       types.Int64    `tfsdk:"color"`
+	Hoist       types.Bool     `tfsdk:"hoist"`
+	Mentionable types.Bool     `tfsdk:"mentionable"`
+	Permissions types.Int64    `tfsdk:"permissions"`
+	Position    types.Int64    `tfsdk:"position"`
+	Managed     types.Bool     `tfsdk:"managed"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewEveryoneRoleResource is a helper function to simplify testing.
@@ -44,22 +53,36 @@ func (r *everyoneRoleResource) Metadata(_ context.Context, req resource.Metadata
 }
 
 // Schema defines the schema for the resource.
-func (r *everyoneRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *everyoneRoleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages the @everyone role in a Discord guild (server). The @everyone role is a special default role that always exists and cannot be created or deleted, only modified.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of the @everyone role (same as guild_id).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the guild (server) where the @everyone role will be managed.",
-				Required:    true,
+				Description: "The ID of the guild (server) where the @everyone role will be managed. Changing this forces a new resource. Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"color": schema.Int64Attribute{
 				Description: "The color of the role as a decimal integer (0-16777215). 0 means no color.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, maxColor),
+				},
 			},
 			"hoist": schema.BoolAttribute{
 				Description: "Whether to display the role's users separately in the member list.",
@@ -75,6 +98,9 @@ func (r *everyoneRoleResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "The permissions integer for the role on the guild. This is a combination of bit masks.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 			},
 			"position": schema.Int64Attribute{
 				Description: "The position of the role in the guild's role hierarchy. This is always 0 for @everyone role.",
@@ -85,6 +111,14 @@ func (r *everyoneRoleResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -94,16 +128,22 @@ func (r *everyoneRoleResource) Configure(_ context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.defaultGuildID = providerData.guildID
+}
+
+// ModifyPlan fills in guild_id from the provider's default guild when it is not configured.
+func (r *everyoneRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanGuildID(ctx, r.defaultGuildID, req, resp)
 }
 
 // Create reads the existing @everyone role and sets the initial Terraform state.
@@ -117,6 +157,17 @@ func (r *everyoneRoleResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_everyone_role", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -136,11 +187,11 @@ func (r *everyoneRoleResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Fetch the existing @everyone role (it always exists)
-	roles, err := r.client.GuildRoles(guildID)
+	roles, err := r.client.GuildRoles(guildID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching @everyone Role",
-			fmt.Sprintf("Unable to fetch roles for guild %s: %s", guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles for guild %s", guildID), err),
 		)
 		return
 	}
@@ -217,11 +268,11 @@ func (r *everyoneRoleResource) Create(ctx context.Context, req resource.CreateRe
 		// Check if we're trying to update permissions - this requires special permissions
 		updatingPermissions := roleParams.Permissions != nil
 
-		updatedRole, err := r.client.GuildRoleEdit(guildID, everyoneRole.ID, roleParams)
+		updatedRole, err := r.client.GuildRoleEdit(guildID, everyoneRole.ID, roleParams, discordgo.WithContext(ctx))
 		if err != nil {
 			// Provide more helpful error message for permission issues
-			errorMsg := fmt.Sprintf("Unable to update @everyone role in guild %s: %s", guildID, err.Error())
-			if err.Error() != "" && (err.Error() == "HTTP 403 Forbidden" || err.Error() == "Missing Permissions") {
+			errorMsg := discordErrorDetail(fmt.Sprintf("Unable to update @everyone role in guild %s", guildID), err)
+			if classifyDiscordError(err) == discordErrorMissingPermissions {
 				errorMsg += "\n\nTo manage the @everyone role, the bot needs:\n" +
 					"1. 'Manage Roles' permission in the server\n" +
 					"2. The bot's role must be higher in the role hierarchy than @everyone\n" +
@@ -256,6 +307,8 @@ func (r *everyoneRoleResource) Create(ctx context.Context, req resource.CreateRe
 	data.Position = types.Int64Value(int64(everyoneRole.Position))
 	data.Managed = types.BoolValue(everyoneRole.Managed)
 
+	logResourceCreated(ctx, data.ID)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -270,6 +323,17 @@ func (r *everyoneRoleResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_everyone_role", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -289,11 +353,19 @@ func (r *everyoneRoleResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Fetch all roles and find the @everyone role
-	roles, err := r.client.GuildRoles(guildID)
+	roles, err := r.client.GuildRoles(guildID, discordgo.WithContext(ctx))
 	if err != nil {
+		if isDiscordNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Guild Not Found",
+				fmt.Sprintf("Guild %s was not found, so its @everyone role is no longer managed. Removing from state.", guildID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Fetching Roles",
-			fmt.Sprintf("Unable to fetch roles for guild %s: %s", guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles for guild %s", guildID), err),
 		)
 		return
 	}
@@ -340,6 +412,17 @@ func (r *everyoneRoleResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_everyone_role", "update", state.ID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -359,11 +442,11 @@ func (r *everyoneRoleResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Fetch the @everyone role
-	roles, err := r.client.GuildRoles(guildID)
+	roles, err := r.client.GuildRoles(guildID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching @everyone Role",
-			fmt.Sprintf("Unable to fetch roles for guild %s: %s", guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles for guild %s", guildID), err),
 		)
 		return
 	}
@@ -434,11 +517,11 @@ func (r *everyoneRoleResource) Update(ctx context.Context, req resource.UpdateRe
 	} else {
 		// Update the role
 		var err error
-		role, err = r.client.GuildRoleEdit(guildID, everyoneRole.ID, roleParams)
+		role, err = r.client.GuildRoleEdit(guildID, everyoneRole.ID, roleParams, discordgo.WithContext(ctx))
 		if err != nil {
 			// Provide more helpful error message for permission issues
-			errorMsg := fmt.Sprintf("Unable to update @everyone role in guild %s: %s", guildID, err.Error())
-			if err.Error() != "" && (err.Error() == "HTTP 403 Forbidden" || err.Error() == "Missing Permissions") {
+			errorMsg := discordErrorDetail(fmt.Sprintf("Unable to update @everyone role in guild %s", guildID), err)
+			if classifyDiscordError(err) == discordErrorMissingPermissions {
 				errorMsg += "\n\nTo manage the @everyone role, the bot needs:\n" +
 					"1. 'Manage Roles' permission in the server\n" +
 					"2. The bot's role must be higher in the role hierarchy than @everyone\n" +
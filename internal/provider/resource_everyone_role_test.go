@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -33,7 +36,8 @@ func TestEveryoneRoleResource_Schema(t *testing.T) {
 	// Check required attribute
 	guildIDAttr, ok := resp.Schema.Attributes["guild_id"]
 	assert.True(t, ok)
-	assert.True(t, guildIDAttr.IsRequired())
+	assert.True(t, guildIDAttr.IsOptional(), "guild_id defaults to the provider's guild_id")
+	assert.True(t, guildIDAttr.IsComputed())
 
 	// Check optional attributes
 	colorAttr, ok := resp.Schema.Attributes["color"]
@@ -54,8 +58,8 @@ func TestEveryoneRoleResource_Configure(t *testing.T) {
 		errorContains string
 	}{
 		{
-			name:         "valid discordgo.Session",
-			providerData: &discordgo.Session{},
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
 			expectError:  false,
 		},
 		{
@@ -92,3 +96,42 @@ func TestEveryoneRoleResource_Configure(t *testing.T) {
 		})
 	}
 }
+
+func TestAccEveryoneRoleResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+
+	permissions := int64(discordgo.PermissionViewChannel | discordgo.PermissionSendMessages)
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "discord_everyone_role" "test" {
+  guild_id    = %q
+  permissions = %d
+  mentionable = false
+}
+`, guild.ID, permissions),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_everyone_role.test", "id", guild.ID),
+					tfresource.TestCheckResourceAttr("discord_everyone_role.test", "permissions", fmt.Sprint(permissions)),
+					func(*terraform.State) error {
+						role, ok := s.Role(guild.ID, guild.ID)
+						if !ok || role.Permissions != permissions {
+							return fmt.Errorf("@everyone permissions were not updated: %+v", role)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		// The @everyone role cannot be deleted, so destroying the resource leaves it in place
+		CheckDestroy: func(*terraform.State) error {
+			if _, ok := s.Role(guild.ID, guild.ID); !ok {
+				return fmt.Errorf("@everyone role was deleted")
+			}
+			return nil
+		},
+	})
+}
@@ -5,9 +5,16 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -23,17 +30,18 @@ type inviteResource struct {
 
 // inviteResourceModel describes the resource data model.
 type inviteResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Code      types.String `tfsdk:"code"`
-	ChannelID types.String `tfsdk:"channel_id"`
-	MaxAge    types.Int64  `tfsdk:"max_age"`
-	MaxUses   types.Int64  `tfsdk:"max_uses"`
-	Temporary types.Bool   `tfsdk:"temporary"`
-	Unique    types.Bool   `tfsdk:"unique"`
-	URL       types.String `tfsdk:"url"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	ExpiresAt types.String `tfsdk:"expires_at"`
-	Uses      types.Int64  `tfsdk:"uses"`
+	ID        types.String   `tfsdk:"id"`
+	Code      types.String   `tfsdk:"code"`
+	ChannelID types.String   `tfsdk:"channel_id"`
+	MaxAge    types.Int64    `tfsdk:"max_age"`
+	MaxUses   types.Int64    `tfsdk:"max_uses"`
+	Temporary types.Bool     `tfsdk:"temporary"`
+	Unique    types.Bool     `tfsdk:"unique"`
+	URL       types.String   `tfsdk:"url"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+	ExpiresAt types.String   `tfsdk:"expires_at"`
+	Uses      types.Int64    `tfsdk:"uses"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewInviteResource is a helper function to simplify testing.
@@ -47,59 +55,110 @@ func (r *inviteResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 // Schema defines the schema for the resource.
-func (r *inviteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *inviteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates and manages a Discord invite for a channel.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of the invite (same as code).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"code": schema.StringAttribute{
 				Description: "The invite code (unique identifier for the invite).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"channel_id": schema.StringAttribute{
-				Description: "The ID of the channel to create an invite for.",
+				Description: "The ID of the channel to create an invite for. Changing this forces a new resource.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"max_age": schema.Int64Attribute{
-				Description: "Duration (in seconds) after which the invite expires. 0 means the invite never expires. Defaults to 86400 (24 hours).",
+				Description: "Duration (in seconds) after which the invite expires. 0 means the invite never expires. Defaults to 86400 (24 hours). Changing this forces a new resource.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, maxInviteAge),
+				},
 			},
 			"max_uses": schema.Int64Attribute{
-				Description: "Maximum number of times the invite can be used. 0 means unlimited. Defaults to 0.",
+				Description: "Maximum number of times the invite can be used. 0 means unlimited. Defaults to 0. Changing this forces a new resource.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, maxInviteUses),
+				},
 			},
 			"temporary": schema.BoolAttribute{
-				Description: "Whether the invite grants temporary membership. If true, members will be kicked when they disconnect unless they're assigned a role. Defaults to false.",
+				Description: "Whether the invite grants temporary membership. If true, members will be kicked when they disconnect unless they're assigned a role. Defaults to false. Changing this forces a new resource.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
 			},
 			"unique": schema.BoolAttribute{
-				Description: "Whether the invite should be unique. If true, Discord will try to reuse a similar invite. Defaults to false.",
+				Description: "Whether the invite should be unique. If true, Discord will try to reuse a similar invite. Defaults to false. Changing this forces a new resource.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
 			},
 			"url": schema.StringAttribute{
 				Description: "The full invite URL (https://discord.gg/{code}).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				Description: "When the invite was created (ISO 8601 timestamp).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"expires_at": schema.StringAttribute{
 				Description: "When the invite expires (ISO 8601 timestamp). Null if max_age is 0.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"uses": schema.Int64Attribute{
 				Description: "Number of times the invite has been used.",
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -109,16 +168,16 @@ func (r *inviteResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -131,6 +190,17 @@ func (r *inviteResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_invite", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -204,11 +274,11 @@ func (r *inviteResource) Create(ctx context.Context, req resource.CreateRequest,
 		MaxUses:   inviteData.MaxUses,
 		Temporary: inviteData.Temporary,
 		Unique:    inviteData.Unique,
-	})
+	}, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Invite",
-			fmt.Sprintf("Unable to create invite for channel %s: %s", channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to create invite for channel %s", channelID), err),
 		)
 		return
 	}
@@ -258,6 +328,8 @@ func (r *inviteResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Uses count
 	data.Uses = types.Int64Value(int64(invite.Uses))
 
+	logResourceCreated(ctx, data.ID)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -272,6 +344,17 @@ func (r *inviteResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_invite", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -296,14 +379,21 @@ func (r *inviteResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Fetch the invite with additional metadata
-	invite, err := r.client.InviteWithCounts(code)
+	invite, err := r.client.InviteWithCounts(code, discordgo.WithContext(ctx))
 	if err != nil {
-		// If invite doesn't exist or was deleted, mark as removed
-		resp.Diagnostics.AddWarning(
-			"Invite Not Found",
-			fmt.Sprintf("Invite %s was not found. It may have been deleted or expired. Removing from state.", code),
+		if isDiscordNotFound(err) {
+			// If invite doesn't exist or was deleted, mark as removed
+			resp.Diagnostics.AddWarning(
+				"Invite Not Found",
+				fmt.Sprintf("Invite %s was not found. It may have been deleted or expired. Removing from state.", code),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Fetching Invite",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch invite %s", code), err),
 		)
-		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -361,6 +451,17 @@ func (r *inviteResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_invite", "update", state.ID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -370,16 +471,7 @@ func (r *inviteResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Check if channel_id changed - this is not allowed
-	if plan.ChannelID.ValueString() != state.ChannelID.ValueString() {
-		resp.Diagnostics.AddError(
-			"Cannot Change Channel",
-			"Discord invites cannot be moved to a different channel. Delete this invite and create a new one for the new channel.",
-		)
-		return
-	}
-
-	// Get the old invite code
+	// Get the invite code
 	code := state.Code.ValueString()
 	if code == "" {
 		code = state.ID.ValueString()
@@ -393,117 +485,8 @@ func (r *inviteResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Check if any immutable fields changed
-	oldMaxAge := state.MaxAge.ValueInt64()
-	newMaxAge := oldMaxAge
-	if !plan.MaxAge.IsNull() && !plan.MaxAge.IsUnknown() {
-		newMaxAge = plan.MaxAge.ValueInt64()
-	}
-
-	oldMaxUses := state.MaxUses.ValueInt64()
-	newMaxUses := oldMaxUses
-	if !plan.MaxUses.IsNull() && !plan.MaxUses.IsUnknown() {
-		newMaxUses = plan.MaxUses.ValueInt64()
-	}
-
-	oldTemporary := state.Temporary.ValueBool()
-	newTemporary := oldTemporary
-	if !plan.Temporary.IsNull() && !plan.Temporary.IsUnknown() {
-		newTemporary = plan.Temporary.ValueBool()
-	}
-
-	oldUnique := state.Unique.ValueBool()
-	newUnique := oldUnique
-	if !plan.Unique.IsNull() && !plan.Unique.IsUnknown() {
-		newUnique = plan.Unique.ValueBool()
-	}
-
-	// If any immutable fields changed, delete old invite and create new one
-	needsRecreate := (newMaxAge != oldMaxAge) || (newMaxUses != oldMaxUses) || (newTemporary != oldTemporary) || (newUnique != oldUnique)
-
-	if needsRecreate {
-		// Delete the old invite
-		_, err := r.client.InviteDelete(code)
-		if err != nil {
-			resp.Diagnostics.AddWarning(
-				"Could Not Delete Old Invite",
-				fmt.Sprintf("Unable to delete old invite %s: %s. Continuing with creation of new invite.", code, err.Error()),
-			)
-		}
-
-		// Create new invite with new settings (reuse Create logic)
-		channelID := plan.ChannelID.ValueString()
-
-		// Set max_age
-		maxAge := int64(86400)
-		if !plan.MaxAge.IsNull() && !plan.MaxAge.IsUnknown() {
-			maxAge = plan.MaxAge.ValueInt64()
-		}
-
-		// Set max_uses
-		maxUses := int64(0)
-		if !plan.MaxUses.IsNull() && !plan.MaxUses.IsUnknown() {
-			maxUses = plan.MaxUses.ValueInt64()
-		}
-
-		// Set temporary
-		temporary := false
-		if !plan.Temporary.IsNull() && !plan.Temporary.IsUnknown() {
-			temporary = plan.Temporary.ValueBool()
-		}
-
-		// Set unique
-		unique := false
-		if !plan.Unique.IsNull() && !plan.Unique.IsUnknown() {
-			unique = plan.Unique.ValueBool()
-		}
-
-		// Create the new invite
-		invite, err := r.client.ChannelInviteCreate(channelID, discordgo.Invite{
-			MaxAge:    int(maxAge),
-			MaxUses:   int(maxUses),
-			Temporary: temporary,
-			Unique:    unique,
-		})
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Creating New Invite",
-				fmt.Sprintf("Unable to create new invite for channel %s: %s", channelID, err.Error()),
-			)
-			return
-		}
-
-		// Populate state with new invite data
-		data := plan
-		data.ID = types.StringValue(invite.Code)
-		data.Code = types.StringValue(invite.Code)
-		data.ChannelID = types.StringValue(channelID)
-		data.MaxAge = types.Int64Value(int64(invite.MaxAge))
-		data.MaxUses = types.Int64Value(int64(invite.MaxUses))
-		data.Temporary = types.BoolValue(invite.Temporary)
-		data.Unique = types.BoolValue(invite.Unique)
-		data.URL = types.StringValue(fmt.Sprintf("https://discord.gg/%s", invite.Code))
-
-		if !invite.CreatedAt.IsZero() {
-			data.CreatedAt = types.StringValue(invite.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
-		} else {
-			data.CreatedAt = types.StringNull()
-		}
-
-		if invite.ExpiresAt != nil && !invite.ExpiresAt.IsZero() {
-			data.ExpiresAt = types.StringValue(invite.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"))
-		} else {
-			data.ExpiresAt = types.StringNull()
-		}
-
-		data.Uses = types.Int64Value(int64(invite.Uses))
-
-		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-		return
-	}
-
-	// No immutable fields changed, just read current state
-	invite, err := r.client.InviteWithCounts(code)
+	// Every invite setting forces a new invite, so an update only refreshes the computed attributes
+	invite, err := r.client.InviteWithCounts(code, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invite Not Found",
@@ -551,6 +534,17 @@ func (r *inviteResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_invite", "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -575,11 +569,11 @@ func (r *inviteResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	// Delete the invite
-	_, err := r.client.InviteDelete(code)
+	_, err := r.client.InviteDelete(code, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Invite",
-			fmt.Sprintf("Unable to delete invite %s: %s", code, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to delete invite %s", code), err),
 		)
 		return
 	}
@@ -587,6 +581,8 @@ func (r *inviteResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // ImportState imports an existing resource into Terraform.
 func (r *inviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_invite", "import", types.StringValue(req.ID))
+
 	// Import by invite code
 	code := req.ID
 	if code == "" {
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccInviteResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	channel := s.AddChannel(guild.ID, "welcome", discordgo.ChannelTypeGuildText, "")
+
+	config := providerConfig + fmt.Sprintf(`
+resource "discord_invite" "test" {
+  channel_id = %q
+  max_age    = 3600
+  max_uses   = 10
+}
+`, channel.ID)
+
+	var code string
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: config,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_invite.test", "max_age", "3600"),
+					tfresource.TestCheckResourceAttr("discord_invite.test", "max_uses", "10"),
+					tfresource.TestCheckResourceAttrSet("discord_invite.test", "expires_at"),
+					testAccCaptureAttr("discord_invite.test", "code", &code),
+				),
+			},
+			{
+				ResourceName:      "discord_invite.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// An invite revoked outside Terraform is removed from state and created again
+				PreConfig: func() { s.DeleteInvite(code) },
+				Config:    config,
+				Check:     tfresource.TestCheckResourceAttrSet("discord_invite.test", "code"),
+			},
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, rs := range state.RootModule().Resources {
+				if _, ok := s.Invite(rs.Primary.ID); ok {
+					return fmt.Errorf("invite %s still exists", rs.Primary.ID)
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,608 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the resource type implements the required interfaces.
+var _ resource.Resource = &memberRolesResource{}
+var _ resource.ResourceWithConfigure = &memberRolesResource{}
+var _ resource.ResourceWithImportState = &memberRolesResource{}
+var _ resource.ResourceWithModifyPlan = &memberRolesResource{}
+
+// memberRolesResource defines the resource implementation.
+type memberRolesResource struct {
+	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
+}
+
+// memberRolesResourceModel describes the resource data model.
+type memberRolesResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	GuildID  types.String   `tfsdk:"guild_id"`
+	UserID   types.String   `tfsdk:"user_id"`
+	RoleIDs  types.Set      `tfsdk:"role_ids"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewMemberRolesResource is a helper function to simplify testing.
+func NewMemberRolesResource() resource.Resource {
+	return &memberRolesResource{}
+}
+
+// botHighestRole returns the highest positioned role held by the bot in the guild, or nil if the bot has no roles.
+func botHighestRole(ctx context.Context, client *discordgo.Session, guildID string, roles []*discordgo.Role) (*discordgo.Role, error) {
+	bot, err := client.User("@me", discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the bot user: %w", err)
+	}
+
+	member, err := client.GuildMember(guildID, bot.ID, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the bot member in guild %s: %w", guildID, err)
+	}
+
+	rolesByID := make(map[string]*discordgo.Role, len(roles))
+	for _, role := range roles {
+		rolesByID[role.ID] = role
+	}
+
+	var highest *discordgo.Role
+	for _, roleID := range member.Roles {
+		role, ok := rolesByID[roleID]
+		if !ok {
+			continue
+		}
+		if highest == nil || role.Position > highest.Position {
+			highest = role
+		}
+	}
+
+	return highest, nil
+}
+
+// managedRoleIDs returns the IDs of the given member roles that are managed by Discord or an integration.
+func managedRoleIDs(memberRoles []string, roles []*discordgo.Role) []string {
+	managed := make(map[string]struct{})
+	for _, role := range roles {
+		if role.Managed {
+			managed[role.ID] = struct{}{}
+		}
+	}
+
+	ids := make([]string, 0)
+	for _, roleID := range memberRoles {
+		if _, ok := managed[roleID]; ok {
+			ids = append(ids, roleID)
+		}
+	}
+
+	return ids
+}
+
+// Metadata returns the resource type name.
+func (r *memberRolesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member_roles"
+}
+
+// Schema defines the schema for the resource.
+func (r *memberRolesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete role list of a Discord guild member. Roles not listed in role_ids are removed from the member. " +
+			"Managed roles (such as the booster role and integration roles) cannot be assigned and are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the member role set (format: guild_id:user_id).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild (server) where the member is located. Changing this forces a new resource. Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user (member) whose roles are managed. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"role_ids": schema.SetAttribute{
+				Description: "The IDs of all roles the member should have. Every role must be positioned below the bot's highest role and must not be managed.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					snowflakeSetValidator(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up the resource with the provider's configured client.
+func (r *memberRolesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.defaultGuildID = providerData.guildID
+}
+
+// ModifyPlan rejects roles the bot cannot assign before any change is applied.
+func (r *memberRolesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanGuildID(ctx, r.defaultGuildID, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to validate on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan memberRolesResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.GuildID.IsUnknown() || plan.RoleIDs.IsUnknown() {
+		return
+	}
+
+	// Roles being removed must be assignable too, so check both sides of the change
+	planned, diags := stringsFromSet(ctx, plan.RoleIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guildID := plan.GuildID.ValueString()
+
+	var current []string
+	if !req.State.Raw.IsNull() {
+		var state memberRolesResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		current, diags = stringsFromSet(ctx, state.RoleIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Skip the API calls when the role set does not change
+		if len(stringSetDifference(planned, current)) == 0 && len(stringSetDifference(current, planned)) == 0 {
+			return
+		}
+	}
+
+	roles, err := r.client.GuildRoles(guildID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Roles",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles for guild %s", guildID), err),
+		)
+		return
+	}
+
+	// On create, compare against the roles the member already holds
+	if req.State.Raw.IsNull() && !plan.UserID.IsUnknown() {
+		member, err := r.client.GuildMember(guildID, plan.UserID.ValueString(), discordgo.WithContext(ctx))
+		if err == nil {
+			current = stringSetDifference(member.Roles, managedRoleIDs(member.Roles, roles))
+		}
+	}
+
+	changed := append(stringSetDifference(planned, current), stringSetDifference(current, planned)...)
+	if len(changed) == 0 {
+		return
+	}
+
+	highest, err := botHighestRole(ctx, r.client, guildID, roles)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Bot Role",
+			discordErrorDetail(fmt.Sprintf("Unable to determine the bot's highest role in guild %s", guildID), err),
+		)
+		return
+	}
+
+	rolesByID := make(map[string]*discordgo.Role, len(roles))
+	for _, role := range roles {
+		rolesByID[role.ID] = role
+	}
+
+	for _, roleID := range changed {
+		role, ok := rolesByID[roleID]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("role_ids"),
+				"Role Not Found",
+				fmt.Sprintf("Role %s does not exist in guild %s.", roleID, guildID),
+			)
+			continue
+		}
+
+		if roleID == guildID {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("role_ids"),
+				"Cannot Assign @everyone Role",
+				"The @everyone role is held by every member implicitly and must not be listed in role_ids.",
+			)
+			continue
+		}
+
+		if role.Managed {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("role_ids"),
+				"Cannot Assign Managed Role",
+				fmt.Sprintf("Role %s (%s) is managed by Discord or an integration and cannot be assigned or removed by a bot.", role.Name, role.ID),
+			)
+			continue
+		}
+
+		if highest == nil || role.Position >= highest.Position {
+			botRole := "the bot has no roles"
+			if highest != nil {
+				botRole = fmt.Sprintf("the bot's highest role is %s at position %d", highest.Name, highest.Position)
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("role_ids"),
+				"Role Above Bot's Highest Role",
+				fmt.Sprintf("Role %s (%s) is at position %d, but %s. "+
+					"A bot can only assign roles positioned below its own highest role. Move the bot's role above %s in Server Settings → Roles.",
+					role.Name, role.ID, role.Position, botRole, role.Name),
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *memberRolesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data memberRolesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_member_roles", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	guildID := data.GuildID.ValueString()
+	if guildID == "" {
+		resp.Diagnostics.AddError(
+			"Missing Guild ID",
+			"The guild_id attribute is required.",
+		)
+		return
+	}
+
+	userID := data.UserID.ValueString()
+	if userID == "" {
+		resp.Diagnostics.AddError(
+			"Missing User ID",
+			"The user_id attribute is required.",
+		)
+		return
+	}
+
+	roleIDs, diags := stringsFromSet(ctx, data.RoleIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setMemberRoles(ctx, guildID, userID, roleIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Setting Member Roles",
+			discordErrorDetail(fmt.Sprintf("Unable to set roles of user %s in guild %s", userID, guildID), err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", guildID, userID))
+
+	logResourceCreated(ctx, data.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *memberRolesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data memberRolesResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_member_roles", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	guildID := data.GuildID.ValueString()
+	userID := data.UserID.ValueString()
+
+	if guildID == "" || userID == "" {
+		resp.Diagnostics.AddError(
+			"Missing Required Fields",
+			"The guild_id and user_id are required to read the member roles.",
+		)
+		return
+	}
+
+	member, err := r.client.GuildMember(guildID, userID, discordgo.WithContext(ctx))
+	if err != nil {
+		if isDiscordNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Member Not Found",
+				fmt.Sprintf("Member %s was not found in guild %s. They may have left the server. Removing from state.", userID, guildID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Fetching Member",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch member %s from guild %s", userID, guildID), err),
+		)
+		return
+	}
+
+	roles, err := r.client.GuildRoles(guildID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Roles",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles for guild %s", guildID), err),
+		)
+		return
+	}
+
+	// Managed roles are not controlled by this resource, so they are left out of state
+	assignable := stringSetDifference(member.Roles, managedRoleIDs(member.Roles, roles))
+
+	roleIDs, diags := stringsToSet(assignable)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", guildID, userID))
+	data.RoleIDs = roleIDs
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *memberRolesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan memberRolesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_member_roles", "update", plan.ID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	guildID := plan.GuildID.ValueString()
+	userID := plan.UserID.ValueString()
+
+	roleIDs, diags := stringsFromSet(ctx, plan.RoleIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setMemberRoles(ctx, guildID, userID, roleIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Setting Member Roles",
+			discordErrorDetail(fmt.Sprintf("Unable to set roles of user %s in guild %s", userID, guildID), err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", guildID, userID))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *memberRolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data memberRolesResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_member_roles", "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	guildID := data.GuildID.ValueString()
+	userID := data.UserID.ValueString()
+
+	if guildID == "" || userID == "" {
+		resp.Diagnostics.AddError(
+			"Missing Required Fields",
+			"The guild_id and user_id are required to delete the member roles.",
+		)
+		return
+	}
+
+	// Removing every assignable role leaves only the managed roles on the member
+	if err := r.setMemberRoles(ctx, guildID, userID, []string{}); err != nil {
+		// A member who has left the guild has no roles left to remove
+		if isDiscordNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Removing Member Roles",
+			discordErrorDetail(fmt.Sprintf("Unable to remove roles of user %s in guild %s", userID, guildID), err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *memberRolesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_member_roles", "import", types.StringValue(req.ID))
+
+	// Import format: guild_id:user_id
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID Format",
+			"The import ID must be in the format 'guild_id:user_id' (e.g., '123456789012345678:111111111111111111').",
+		)
+		return
+	}
+
+	// Set the IDs in state - Read will populate role_ids
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), types.StringValue(parts[0]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), types.StringValue(parts[1]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+}
+
+// setMemberRoles replaces the member's assignable roles with roleIDs in a single GuildMemberEdit call.
+// Managed roles the member currently holds are kept, because Discord rejects edits that drop them.
+func (r *memberRolesResource) setMemberRoles(ctx context.Context, guildID, userID string, roleIDs []string) error {
+	member, err := r.client.GuildMember(guildID, userID, discordgo.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("unable to fetch member: %w", err)
+	}
+
+	roles, err := r.client.GuildRoles(guildID, discordgo.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("unable to fetch roles: %w", err)
+	}
+
+	managed := managedRoleIDs(member.Roles, roles)
+	newRoles := append(managed, stringSetDifference(roleIDs, managed)...)
+
+	_, err = r.client.GuildMemberEdit(guildID, userID, &discordgo.GuildMemberParams{
+		Roles: &newRoles,
+	}, discordgo.WithContext(ctx))
+	return err
+}
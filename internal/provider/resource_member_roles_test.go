@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemberRolesResource_Metadata(t *testing.T) {
+	r := NewMemberRolesResource()
+	req := resource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_member_roles", resp.TypeName)
+}
+
+func TestMemberRolesResource_Schema(t *testing.T) {
+	r := NewMemberRolesResource()
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(t.Context(), req, resp)
+
+	assert.NotNil(t, resp.Schema)
+	assert.Contains(t, resp.Schema.Description, "Manages the complete role list of a Discord guild member")
+
+	// Check required attributes
+	requiredAttrs := []string{"user_id", "role_ids"}
+	for _, attrName := range requiredAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsRequired(), "Attribute %s should be required", attrName)
+	}
+
+	// guild_id defaults to the provider's guild_id
+	guildIDAttr, ok := resp.Schema.Attributes["guild_id"]
+	assert.True(t, ok)
+	assert.True(t, guildIDAttr.IsOptional())
+	assert.True(t, guildIDAttr.IsComputed())
+
+	// Check computed attributes
+	idAttr, ok := resp.Schema.Attributes["id"]
+	assert.True(t, ok)
+	assert.True(t, idAttr.IsComputed())
+}
+
+func TestManagedRoleIDs(t *testing.T) {
+	roles := []*discordgo.Role{
+		{ID: "1", Name: "Staff"},
+		{ID: "2", Name: "Server Booster", Managed: true},
+		{ID: "3", Name: "GitHub", Managed: true},
+	}
+
+	assert.Equal(t, []string{"2"}, managedRoleIDs([]string{"1", "2"}, roles))
+	assert.Equal(t, []string{}, managedRoleIDs([]string{"1"}, roles))
+	assert.Equal(t, []string{}, managedRoleIDs([]string{"4"}, roles))
+}
+
+func TestAccMemberRolesResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	members := s.AddRole(guild.ID, "Members")
+	moderators := s.AddRole(guild.ID, "Moderators")
+	admins := s.AddRole(guild.ID, "Admins")
+	// Move Admins above the bot's own role, out of its reach
+	s.EditRole(guild.ID, admins.ID, func(role *discordgo.Role) { role.Position = 10 })
+	alice := s.AddUser("alice")
+	s.AddMember(guild.ID, alice)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + testAccMemberRolesResourceConfig(guild.ID, alice.ID, members.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_member_roles.test", "role_ids.#", "1"),
+					testAccCheckMemberHasRole(s, guild.ID, alice.ID, members.ID, true),
+				),
+			},
+			{
+				Config: providerConfig + testAccMemberRolesResourceConfig(guild.ID, alice.ID, moderators.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					testAccCheckMemberHasRole(s, guild.ID, alice.ID, members.ID, false),
+					testAccCheckMemberHasRole(s, guild.ID, alice.ID, moderators.ID, true),
+				),
+			},
+			{
+				// Granting a role above the bot's highest role fails at plan time
+				Config:      providerConfig + testAccMemberRolesResourceConfig(guild.ID, alice.ID, moderators.ID, admins.ID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Role Above Bot's Highest Role`),
+			},
+			{
+				ResourceName:      "discord_member_roles.test",
+				ImportState:       true,
+				ImportStateId:     guild.ID + ":" + alice.ID,
+				ImportStateVerify: true,
+			},
+			{
+				// A role removed outside Terraform is granted again
+				PreConfig: func() { s.RemoveMemberRole(guild.ID, alice.ID, moderators.ID) },
+				Config:    providerConfig + testAccMemberRolesResourceConfig(guild.ID, alice.ID, moderators.ID),
+				Check:     testAccCheckMemberHasRole(s, guild.ID, alice.ID, moderators.ID, true),
+			},
+		},
+	})
+}
+
+func TestMemberRolesResource_DeleteAfterMemberLeft(t *testing.T) {
+	s, _ := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	role := s.AddRole(guild.ID, "Members")
+	alice := s.AddUser("alice")
+	s.AddMember(guild.ID, alice, role.ID)
+	s.RemoveMember(guild.ID, alice.ID)
+
+	r := &memberRolesResource{client: testFakeClient(t, s)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(t.Context(), resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(t.Context()), nil)}
+	roleIDs, diags := stringsToSet([]string{role.ID})
+	require.False(t, diags.HasError())
+	require.False(t, state.Set(t.Context(), &memberRolesResourceModel{
+		ID:       types.StringValue(guild.ID + ":" + alice.ID),
+		GuildID:  types.StringValue(guild.ID),
+		UserID:   types.StringValue(alice.ID),
+		RoleIDs:  roleIDs,
+		Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType, "read": types.StringType, "update": types.StringType, "delete": types.StringType})},
+	}).HasError())
+
+	// A member who has left the guild is already gone, so destroy succeeds
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(t.Context(), resource.DeleteRequest{State: state}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+}
+
+func testAccMemberRolesResourceConfig(guildID, userID string, roleIDs ...string) string {
+	return fmt.Sprintf(`
+resource "discord_member_roles" "test" {
+  guild_id = %q
+  user_id  = %q
+  role_ids = %s
+}
+`, guildID, userID, testAccStringList(roleIDs))
+}
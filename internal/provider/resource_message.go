@@ -5,9 +5,14 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -23,14 +28,15 @@ type messageResource struct {
 
 // messageResourceModel describes the resource data model.
 type messageResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	ChannelID types.String `tfsdk:"channel_id"`
-	Content   types.String `tfsdk:"content"`
-	TTS       types.Bool   `tfsdk:"tts"`
-	MessageID types.String `tfsdk:"message_id"`
-	Timestamp types.String `tfsdk:"timestamp"`
-	EditedAt  types.String `tfsdk:"edited_at"`
-	Author    types.String `tfsdk:"author"`
+	ID        types.String   `tfsdk:"id"`
+	ChannelID types.String   `tfsdk:"channel_id"`
+	Content   types.String   `tfsdk:"content"`
+	TTS       types.Bool     `tfsdk:"tts"`
+	MessageID types.String   `tfsdk:"message_id"`
+	Timestamp types.String   `tfsdk:"timestamp"`
+	EditedAt  types.String   `tfsdk:"edited_at"`
+	Author    types.String   `tfsdk:"author"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewMessageResource is a helper function to simplify testing.
@@ -44,21 +50,33 @@ func (r *messageResource) Metadata(_ context.Context, req resource.MetadataReque
 }
 
 // Schema defines the schema for the resource.
-func (r *messageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *messageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates and manages a Discord message in a channel.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of the message (same as message_id).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"channel_id": schema.StringAttribute{
-				Description: "The ID of the channel to send the message to.",
+				Description: "The ID of the channel to send the message to. Changing this forces a new resource.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"content": schema.StringAttribute{
 				Description: "The content of the message. Must be 1-2000 characters. At least one of content or embed must be provided.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2000),
+				},
 			},
 			"tts": schema.BoolAttribute{
 				Description: "Whether the message should be sent as text-to-speech. Defaults to false.",
@@ -67,10 +85,16 @@ func (r *messageResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"message_id": schema.StringAttribute{
 				Description: "The ID of the message.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"timestamp": schema.StringAttribute{
 				Description: "When the message was sent (ISO 8601 timestamp).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"edited_at": schema.StringAttribute{
 				Description: "When the message was last edited (ISO 8601 timestamp). Null if never edited.",
@@ -79,8 +103,19 @@ func (r *messageResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"author": schema.StringAttribute{
 				Description: "The ID of the user who sent the message.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -90,16 +125,16 @@ func (r *messageResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -112,6 +147,17 @@ func (r *messageResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_message", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -165,11 +211,11 @@ func (r *messageResource) Create(ctx context.Context, req resource.CreateRequest
 	message, err := r.client.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
 		Content: content,
 		TTS:     tts,
-	})
+	}, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Sending Message",
-			fmt.Sprintf("Unable to send message to channel %s: %s", channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to send message to channel %s", channelID), err),
 		)
 		return
 	}
@@ -226,6 +272,8 @@ func (r *messageResource) Create(ctx context.Context, req resource.CreateRequest
 		data.Author = types.StringNull()
 	}
 
+	logResourceCreated(ctx, data.ID)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -240,6 +288,17 @@ func (r *messageResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_message", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -264,14 +323,21 @@ func (r *messageResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Fetch the message
-	message, err := r.client.ChannelMessage(channelID, messageID)
+	message, err := r.client.ChannelMessage(channelID, messageID, discordgo.WithContext(ctx))
 	if err != nil {
-		// If message doesn't exist, mark as removed
-		resp.Diagnostics.AddWarning(
-			"Message Not Found",
-			fmt.Sprintf("Message %s was not found in channel %s. It may have been deleted. Removing from state.", messageID, channelID),
+		if isDiscordNotFound(err) {
+			// If message doesn't exist, mark as removed
+			resp.Diagnostics.AddWarning(
+				"Message Not Found",
+				fmt.Sprintf("Message %s was not found in channel %s. It may have been deleted. Removing from state.", messageID, channelID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Fetching Message",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch message %s from channel %s", messageID, channelID), err),
 		)
-		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -320,6 +386,17 @@ func (r *messageResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_message", "update", state.ID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -368,11 +445,11 @@ func (r *messageResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Update the message
-	message, err := r.client.ChannelMessageEdit(channelID, messageID, newContent)
+	message, err := r.client.ChannelMessageEdit(channelID, messageID, newContent, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Message",
-			fmt.Sprintf("Unable to update message %s in channel %s: %s", messageID, channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to update message %s in channel %s", messageID, channelID), err),
 		)
 		return
 	}
@@ -422,6 +499,17 @@ func (r *messageResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_message", "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -446,11 +534,11 @@ func (r *messageResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	// Delete the message
-	err := r.client.ChannelMessageDelete(channelID, messageID)
+	err := r.client.ChannelMessageDelete(channelID, messageID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Message",
-			fmt.Sprintf("Unable to delete message %s from channel %s: %s", messageID, channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to delete message %s from channel %s", messageID, channelID), err),
 		)
 		return
 	}
@@ -458,6 +546,8 @@ func (r *messageResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 // ImportState imports an existing resource into Terraform.
 func (r *messageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_message", "import", types.StringValue(req.ID))
+
 	// Import format: channel_id:message_id
 	importID := req.ID
 	if importID == "" {
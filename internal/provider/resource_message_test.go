@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccMessageResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	channel := s.AddChannel(guild.ID, "rules", discordgo.ChannelTypeGuildText, "")
+
+	var messageID string
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + testAccMessageResourceConfig(channel.ID, "Be nice."),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_message.test", "content", "Be nice."),
+					tfresource.TestCheckResourceAttr("discord_message.test", "author", s.BotUser.ID),
+					testAccCaptureAttr("discord_message.test", "id", &messageID),
+				),
+			},
+			{
+				ResourceName:            "discord_message.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportID("discord_message.test", "channel_id", "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tts"},
+			},
+			{
+				Config: providerConfig + testAccMessageResourceConfig(channel.ID, "Be nice. No spam."),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_message.test", "content", "Be nice. No spam."),
+					tfresource.TestCheckResourceAttrPtr("discord_message.test", "id", &messageID),
+					tfresource.TestCheckResourceAttrSet("discord_message.test", "edited_at"),
+				),
+			},
+			{
+				// A message deleted outside Terraform is removed from state and sent again
+				PreConfig: func() { s.DeleteMessage(channel.ID, messageID) },
+				Config:    providerConfig + testAccMessageResourceConfig(channel.ID, "Be nice. No spam."),
+				Check: func(state *terraform.State) error {
+					id, err := testAccAttr(state, "discord_message.test", "id")
+					if err == nil && id == messageID {
+						return fmt.Errorf("message was not sent again after it was deleted")
+					}
+					return err
+				},
+			},
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, rs := range state.RootModule().Resources {
+				if _, ok := s.Message(channel.ID, rs.Primary.ID); ok {
+					return fmt.Errorf("message %s still exists", rs.Primary.ID)
+				}
+			}
+			return nil
+		},
+	})
+}
+
+func testAccMessageResourceConfig(channelID, content string) string {
+	return fmt.Sprintf(`
+resource "discord_message" "test" {
+  channel_id = %q
+  content    = %q
+}
+`, channelID, content)
+}
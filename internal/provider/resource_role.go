@@ -5,32 +5,43 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the resource type implements the required interfaces.
 var _ resource.Resource = &roleResource{}
 var _ resource.ResourceWithConfigure = &roleResource{}
+var _ resource.ResourceWithModifyPlan = &roleResource{}
 var _ resource.ResourceWithImportState = &roleResource{}
 
 // roleResource defines the resource implementation.
 type roleResource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // roleResourceModel describes the resource data model.
 type roleResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	GuildID     types.String `tfsdk:"guild_id"`
-	Color       types.Int64  `tfsdk:"color"`
-	Hoist       types.Bool   `tfsdk:"hoist"`
-	Mentionable types.Bool   `tfsdk:"mentionable"`
-	Permissions types.Int64  `tfsdk:"permissions"`
-	Position    types.Int64  `tfsdk:"position"`
-	Managed     types.Bool   `tfsdk:"managed"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	GuildID     types.String   `tfsdk:"guild_id"`
+	Color       types.Int64    `tfsdk:"color"`
+	Hoist       types.Bool     `tfsdk:"hoist"`
+	Mentionable types.Bool     `tfsdk:"mentionable"`
+	Permissions types.Int64    `tfsdk:"permissions"`
+	Position    types.Int64    `tfsdk:"position"`
+	Managed     types.Bool     `tfsdk:"managed"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewRoleResource is a helper function to simplify testing.
@@ -44,26 +55,43 @@ func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 // Schema defines the schema for the resource.
-func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *roleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates and manages a Discord role in a guild (server).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of the role.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the role. Must be 1-100 characters.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
 			},
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the guild (server) where the role will be created.",
-				Required:    true,
+				Description: "The ID of the guild (server) where the role will be created. Changing this forces a new resource. Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"color": schema.Int64Attribute{
 				Description: "The color of the role as a decimal integer (0-16777215). 0 means no color.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, maxColor),
+				},
 			},
 			"hoist": schema.BoolAttribute{
 				Description: "Whether to display the role's users separately in the member list.",
@@ -79,6 +107,9 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "The permissions integer for the role on the guild. This is a combination of bit masks.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 			},
 			"position": schema.Int64Attribute{
 				Description: "The position of the role in the guild's role hierarchy. Lower numbers appear higher in the list.",
@@ -87,8 +118,19 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"managed": schema.BoolAttribute{
 				Description: "Whether this role is managed by an integration. This is read-only and set by Discord.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -98,16 +140,22 @@ func (r *roleResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.defaultGuildID = providerData.guildID
+}
+
+// ModifyPlan fills in guild_id from the provider's default guild when it is not configured.
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanGuildID(ctx, r.defaultGuildID, req, resp)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -120,6 +168,17 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_role", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -203,11 +262,11 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Create the role
-	role, err := r.client.GuildRoleCreate(guildID, roleParams)
+	role, err := r.client.GuildRoleCreate(guildID, roleParams, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Role",
-			fmt.Sprintf("Unable to create role %s in guild %s: %s", name, guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to create role %s in guild %s", name, guildID), err),
 		)
 		return
 	}
@@ -243,6 +302,8 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	data.Position = types.Int64Value(int64(role.Position))
 	data.Managed = types.BoolValue(role.Managed)
 
+	logResourceCreated(ctx, data.ID)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -257,6 +318,17 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_role", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -285,11 +357,19 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Fetch all roles and find the one with matching ID
-	roles, err := r.client.GuildRoles(guildID)
+	roles, err := r.client.GuildRoles(guildID, discordgo.WithContext(ctx))
 	if err != nil {
+		if isDiscordNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Guild Not Found",
+				fmt.Sprintf("Guild %s was not found, so role %s no longer exists. Removing from state.", guildID, roleID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Fetching Roles",
-			fmt.Sprintf("Unable to fetch roles for guild %s: %s", guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles for guild %s", guildID), err),
 		)
 		return
 	}
@@ -305,10 +385,11 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	if !found {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.AddWarning(
 			"Role Not Found",
-			fmt.Sprintf("Role with ID %s not found in guild %s. The role may have been deleted.", roleID, guildID),
+			fmt.Sprintf("Role %s was not found in guild %s. It may have been deleted outside of Terraform. Removing from state.", roleID, guildID),
 		)
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -337,6 +418,17 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_role", "update", state.ID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -420,11 +512,11 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// Update the role
-	role, err := r.client.GuildRoleEdit(guildID, roleID, roleParams)
+	role, err := r.client.GuildRoleEdit(guildID, roleID, roleParams, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Role",
-			fmt.Sprintf("Unable to update role %s in guild %s: %s", roleID, guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to update role %s in guild %s", roleID, guildID), err),
 		)
 		return
 	}
@@ -456,6 +548,17 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_role", "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -484,11 +587,11 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	// Delete the role
-	err := r.client.GuildRoleDelete(guildID, roleID)
+	err := r.client.GuildRoleDelete(guildID, roleID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Role",
-			fmt.Sprintf("Unable to delete role %s in guild %s: %s", roleID, guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to delete role %s in guild %s", roleID, guildID), err),
 		)
 		return
 	}
@@ -496,6 +599,8 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 // ImportState imports an existing resource into Terraform state.
 func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_role", "import", types.StringValue(req.ID))
+
 	// Import ID format: guild_id:role_id
 	// Parse the import ID
 	importID := req.ID
@@ -542,11 +647,11 @@ func (r *roleResource) ImportState(ctx context.Context, req resource.ImportState
 	}
 
 	// Fetch the role to populate state
-	roles, err := r.client.GuildRoles(guildID)
+	roles, err := r.client.GuildRoles(guildID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Role",
-			fmt.Sprintf("Unable to fetch roles for guild %s: %s", guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles for guild %s", guildID), err),
 		)
 		return
 	}
@@ -571,6 +676,7 @@ func (r *roleResource) ImportState(ctx context.Context, req resource.ImportState
 
 	// Create a model with the role data
 	var data roleResourceModel
+	data.Timeouts = nullTimeouts()
 	data.ID = types.StringValue(role.ID)
 	data.Name = types.StringValue(role.Name)
 	data.GuildID = types.StringValue(guildID)
@@ -6,28 +6,36 @@ import (
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the resource type implements the required interfaces.
 var _ resource.Resource = &roleMemberResource{}
 var _ resource.ResourceWithConfigure = &roleMemberResource{}
+var _ resource.ResourceWithModifyPlan = &roleMemberResource{}
 var _ resource.ResourceWithImportState = &roleMemberResource{}
 
 // roleMemberResource defines the resource implementation.
 type roleMemberResource struct {
 	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
 }
 
 // roleMemberResourceModel describes the resource data model.
 type roleMemberResourceModel struct {
-	ID      types.String `tfsdk:"id"`
-	GuildID types.String `tfsdk:"guild_id"`
-	RoleID  types.String `tfsdk:"role_id"`
-	UserID  types.String `tfsdk:"user_id"`
+	ID       types.String   `tfsdk:"id"`
+	GuildID  types.String   `tfsdk:"guild_id"`
+	RoleID   types.String   `tfsdk:"role_id"`
+	UserID   types.String   `tfsdk:"user_id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewRoleMemberResource is a helper function to simplify testing.
@@ -41,27 +49,58 @@ func (r *roleMemberResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 // Schema defines the schema for the resource.
-func (r *roleMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *roleMemberResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages the membership of a user in a Discord role. This resource adds or removes a user from a role.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of the role membership (format: guild_id:role_id:user_id).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"guild_id": schema.StringAttribute{
-				Description: "The ID of the guild (server) where the role exists.",
-				Required:    true,
+				Description: "The ID of the guild (server) where the role exists. Changing this forces a new resource. Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"role_id": schema.StringAttribute{
-				Description: "The ID of the role to add the user to.",
+				Description: "The ID of the role to add the user to. Changing this forces a new resource.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"user_id": schema.StringAttribute{
-				Description: "The ID of the user to add to the role.",
+				Description: "The ID of the user to add to the role. Changing this forces a new resource.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -71,16 +110,22 @@ func (r *roleMemberResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.defaultGuildID = providerData.guildID
+}
+
+// ModifyPlan fills in guild_id from the provider's default guild when it is not configured.
+func (r *roleMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanGuildID(ctx, r.defaultGuildID, req, resp)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -93,6 +138,17 @@ func (r *roleMemberResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_role_member", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -130,11 +186,11 @@ func (r *roleMemberResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Add the user to the role
-	err := r.client.GuildMemberRoleAdd(guildID, userID, roleID)
+	err := r.client.GuildMemberRoleAdd(guildID, userID, roleID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Adding User to Role",
-			fmt.Sprintf("Unable to add user %s to role %s in guild %s: %s", userID, roleID, guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to add user %s to role %s in guild %s", userID, roleID, guildID), err),
 		)
 		return
 	}
@@ -142,6 +198,8 @@ func (r *roleMemberResource) Create(ctx context.Context, req resource.CreateRequ
 	// Set the ID (composite key)
 	data.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", guildID, roleID, userID))
 
+	logResourceCreated(ctx, data.ID)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -156,6 +214,17 @@ func (r *roleMemberResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_role_member", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -178,14 +247,21 @@ func (r *roleMemberResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Fetch the guild member to check if they have the role
-	member, err := r.client.GuildMember(guildID, userID)
+	member, err := r.client.GuildMember(guildID, userID, discordgo.WithContext(ctx))
 	if err != nil {
-		// If member doesn't exist or is not in the guild, mark as removed
-		resp.Diagnostics.AddWarning(
-			"Member Not Found",
-			fmt.Sprintf("Member %s was not found in guild %s. They may have left the server. Removing from state.", userID, guildID),
+		if isDiscordNotFound(err) {
+			// If member doesn't exist or is not in the guild, mark as removed
+			resp.Diagnostics.AddWarning(
+				"Member Not Found",
+				fmt.Sprintf("Member %s was not found in guild %s. They may have left the server. Removing from state.", userID, guildID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Fetching Member",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch member %s from guild %s", userID, guildID), err),
 		)
-		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -226,53 +302,11 @@ func (r *roleMemberResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// Ensure client is configured
-	if r.client == nil {
-		resp.Diagnostics.AddError(
-			"Discord Client Not Configured",
-			"The Discord client was not properly configured. This is a provider error.",
-		)
-		return
-	}
-
-	oldGuildID := state.GuildID.ValueString()
-	oldRoleID := state.RoleID.ValueString()
-	oldUserID := state.UserID.ValueString()
-
-	newGuildID := plan.GuildID.ValueString()
-	newRoleID := plan.RoleID.ValueString()
-	newUserID := plan.UserID.ValueString()
-
-	// If any of the IDs changed, we need to remove from old and add to new
-	// This is essentially a replacement
-	if oldGuildID != newGuildID || oldRoleID != newRoleID || oldUserID != newUserID {
-		// Remove from old role
-		if oldGuildID != "" && oldRoleID != "" && oldUserID != "" {
-			err := r.client.GuildMemberRoleRemove(oldGuildID, oldUserID, oldRoleID)
-			if err != nil {
-				resp.Diagnostics.AddWarning(
-					"Error Removing Old Role Membership",
-					fmt.Sprintf("Unable to remove user %s from role %s in guild %s: %s. Continuing with new role assignment.", oldUserID, oldRoleID, oldGuildID, err.Error()),
-				)
-			}
-		}
+	ctx = resourceLogContext(ctx, "discord_role_member", "update", state.ID)
 
-		// Add to new role
-		if newGuildID != "" && newRoleID != "" && newUserID != "" {
-			err := r.client.GuildMemberRoleAdd(newGuildID, newUserID, newRoleID)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error Adding User to New Role",
-					fmt.Sprintf("Unable to add user %s to role %s in guild %s: %s", newUserID, newRoleID, newGuildID, err.Error()),
-				)
-				return
-			}
-		}
-	}
-
-	// Update state
+	// guild_id, role_id and user_id force a new resource, so only settings such as timeouts change in place
 	data := plan
-	data.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", newGuildID, newRoleID, newUserID))
+	data.ID = state.ID
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -288,6 +322,17 @@ func (r *roleMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_role_member", "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -310,11 +355,11 @@ func (r *roleMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	// Remove the user from the role
-	err := r.client.GuildMemberRoleRemove(guildID, userID, roleID)
+	err := r.client.GuildMemberRoleRemove(guildID, userID, roleID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Removing User from Role",
-			fmt.Sprintf("Unable to remove user %s from role %s in guild %s: %s", userID, roleID, guildID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to remove user %s from role %s in guild %s", userID, roleID, guildID), err),
 		)
 		return
 	}
@@ -322,6 +367,8 @@ func (r *roleMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 // ImportState imports an existing resource into Terraform.
 func (r *roleMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_role_member", "import", types.StringValue(req.ID))
+
 	// Import format: guild_id:role_id:user_id
 	importID := req.ID
 	if importID == "" {
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleMemberResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	role := s.AddRole(guild.ID, "Members")
+	alice := s.AddUser("alice")
+	s.AddMember(guild.ID, alice)
+
+	config := providerConfig + fmt.Sprintf(`
+resource "discord_role_member" "test" {
+  guild_id = %q
+  role_id  = %q
+  user_id  = %q
+}
+`, guild.ID, role.ID, alice.ID)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: config,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_role_member.test", "id", guild.ID+":"+role.ID+":"+alice.ID),
+					testAccCheckMemberHasRole(s, guild.ID, alice.ID, role.ID, true),
+				),
+			},
+			{
+				ResourceName:      "discord_role_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// A role removed outside Terraform is granted again
+				PreConfig: func() { s.RemoveMemberRole(guild.ID, alice.ID, role.ID) },
+				Config:    config,
+				Check:     testAccCheckMemberHasRole(s, guild.ID, alice.ID, role.ID, true),
+			},
+		},
+		CheckDestroy: testAccCheckMemberHasRole(s, guild.ID, alice.ID, role.ID, false),
+	})
+}
@@ -0,0 +1,718 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the resource type implements the required interfaces.
+var _ resource.Resource = &roleMembersResource{}
+var _ resource.ResourceWithConfigure = &roleMembersResource{}
+var _ resource.ResourceWithImportState = &roleMembersResource{}
+var _ resource.ResourceWithModifyPlan = &roleMembersResource{}
+
+const (
+	// roleMembersModeAuthoritative makes the configured user_ids the complete member set of the role.
+	roleMembersModeAuthoritative = "authoritative"
+	// roleMembersModeAdditive only ensures the configured user_ids hold the role.
+	roleMembersModeAdditive = "additive"
+)
+
+// guildMembersPageSize is the maximum number of members Discord returns per GuildMembers call.
+const guildMembersPageSize = 1000
+
+// roleMembersResource defines the resource implementation.
+type roleMembersResource struct {
+	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
+}
+
+// roleMembersResourceModel describes the resource data model.
+type roleMembersResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	GuildID  types.String   `tfsdk:"guild_id"`
+	RoleID   types.String   `tfsdk:"role_id"`
+	UserIDs  types.Set      `tfsdk:"user_ids"`
+	Mode     types.String   `tfsdk:"mode"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewRoleMembersResource is a helper function to simplify testing.
+func NewRoleMembersResource() resource.Resource {
+	return &roleMembersResource{}
+}
+
+// fetchAllGuildMembers pages through GuildMembers using the after cursor and returns every member of the guild.
+func fetchAllGuildMembers(ctx context.Context, client *discordgo.Session, guildID string) ([]*discordgo.Member, error) {
+	var all []*discordgo.Member
+	err := pageGuildMembers(ctx, client, guildID, func(page []*discordgo.Member) bool {
+		all = append(all, page...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// pageGuildMembers pages through GuildMembers using the after cursor and passes each page to visit, stopping at
+// the last page or as soon as visit returns false.
+func pageGuildMembers(ctx context.Context, client *discordgo.Session, guildID string, visit func(page []*discordgo.Member) bool) error {
+	after := ""
+
+	for {
+		page, err := client.GuildMembers(guildID, after, guildMembersPageSize, discordgo.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+
+		if !visit(page) || len(page) < guildMembersPageSize {
+			return nil
+		}
+
+		last := page[len(page)-1]
+		if last.User == nil || last.User.ID == "" {
+			return nil
+		}
+		after = last.User.ID
+	}
+}
+
+// roleHolders returns the sorted IDs of every guild member that currently holds the role.
+func roleHolders(ctx context.Context, client *discordgo.Session, guildID, roleID string) ([]string, error) {
+	members, err := fetchAllGuildMembers(ctx, client, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	holders := make([]string, 0)
+	for _, member := range members {
+		if member.User == nil {
+			continue
+		}
+		for _, memberRoleID := range member.Roles {
+			if memberRoleID == roleID {
+				holders = append(holders, member.User.ID)
+				break
+			}
+		}
+	}
+
+	sort.Strings(holders)
+	return holders, nil
+}
+
+// stringSetDifference returns the sorted elements of a that are not in b.
+func stringSetDifference(a, b []string) []string {
+	seen := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		seen[v] = struct{}{}
+	}
+
+	diff := make([]string, 0)
+	for _, v := range a {
+		if _, ok := seen[v]; !ok {
+			diff = append(diff, v)
+		}
+	}
+
+	sort.Strings(diff)
+	return diff
+}
+
+// roleMembersMode returns the effective mode, defaulting to authoritative.
+func roleMembersMode(mode types.String) (string, error) {
+	if mode.IsNull() || mode.IsUnknown() || mode.ValueString() == "" {
+		return roleMembersModeAuthoritative, nil
+	}
+
+	switch mode.ValueString() {
+	case roleMembersModeAuthoritative, roleMembersModeAdditive:
+		return mode.ValueString(), nil
+	default:
+		return "", fmt.Errorf("invalid mode: %s. Valid values are: %s, %s", mode.ValueString(), roleMembersModeAuthoritative, roleMembersModeAdditive)
+	}
+}
+
+// stringsFromSet converts a Terraform set of strings into a sorted Go slice.
+func stringsFromSet(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	values := make([]string, 0)
+	if set.IsNull() || set.IsUnknown() {
+		return values, nil
+	}
+
+	diags := set.ElementsAs(ctx, &values, false)
+	sort.Strings(values)
+	return values, diags
+}
+
+// stringsToSet converts a Go slice of strings into a Terraform set of strings.
+func stringsToSet(values []string) (types.Set, diag.Diagnostics) {
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+
+	return types.SetValue(types.StringType, elements)
+}
+
+// Metadata returns the resource type name.
+func (r *roleMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_members"
+}
+
+// Schema defines the schema for the resource.
+func (r *roleMembersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete member set of a Discord role. In authoritative mode, users that hold the role but are not listed in user_ids are removed from it. " +
+			"Requires the GUILD_MEMBERS privileged intent because the current holders are discovered by listing guild members.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the role membership set (format: guild_id:role_id).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild (server) where the role exists. Changing this forces a new resource. Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description: "The ID of the role whose members are managed. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				Description: "The IDs of the users that should hold the role.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					snowflakeSetValidator(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Description: "How the member set is enforced. Valid values: \"authoritative\" (users not listed in user_ids are removed from the role) and \"additive\" (only the listed users are managed, other holders are left alone). " +
+					"Use \"additive\" to adopt an existing role gradually. Defaults to \"authoritative\".",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(roleMembersModeAuthoritative),
+				Validators: []validator.String{
+					stringvalidator.OneOf(roleMembersModeAuthoritative, roleMembersModeAdditive),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up the resource with the provider's configured client.
+func (r *roleMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.defaultGuildID = providerData.guildID
+}
+
+// ModifyPlan warns about users that the planned change will remove from the role.
+func (r *roleMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanGuildID(ctx, r.defaultGuildID, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to report on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan roleMembersResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.UserIDs.IsUnknown() {
+		return
+	}
+
+	planned, diags := stringsFromSet(ctx, plan.UserIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current []string
+	if req.State.Raw.IsNull() {
+		// On create, authoritative mode takes the role away from every current holder that is not listed
+		mode, err := roleMembersMode(plan.Mode)
+		if err != nil || mode != roleMembersModeAuthoritative || r.client == nil {
+			return
+		}
+		if plan.GuildID.IsUnknown() || plan.RoleID.IsUnknown() {
+			return
+		}
+
+		holders, err := roleHolders(ctx, r.client, plan.GuildID.ValueString(), plan.RoleID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Fetching Role Members",
+				discordMembersErrorDetail(fmt.Sprintf("Unable to list members of role %s in guild %s", plan.RoleID.ValueString(), plan.GuildID.ValueString()), err),
+			)
+			return
+		}
+		current = holders
+	} else {
+		var state roleMembersResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		current, diags = stringsFromSet(ctx, state.UserIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	removed := stringSetDifference(current, planned)
+	if len(removed) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Users Will Be Removed From Role",
+		fmt.Sprintf("Applying this plan will remove %d user(s) from role %s in guild %s: %s",
+			len(removed), plan.RoleID.ValueString(), plan.GuildID.ValueString(), strings.Join(removed, ", ")),
+	)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *roleMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data roleMembersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_role_members", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	guildID := data.GuildID.ValueString()
+	if guildID == "" {
+		resp.Diagnostics.AddError(
+			"Missing Guild ID",
+			"The guild_id attribute is required.",
+		)
+		return
+	}
+
+	roleID := data.RoleID.ValueString()
+	if roleID == "" {
+		resp.Diagnostics.AddError(
+			"Missing Role ID",
+			"The role_id attribute is required.",
+		)
+		return
+	}
+
+	mode, err := roleMembersMode(data.Mode)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Mode",
+			err.Error(),
+		)
+		return
+	}
+
+	desired, diags := stringsFromSet(ctx, data.UserIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// On create nothing is managed yet, so in additive mode no user is removed
+	r.reconcile(ctx, guildID, roleID, mode, desired, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", guildID, roleID))
+	data.Mode = types.StringValue(mode)
+
+	logResourceCreated(ctx, data.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *roleMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data roleMembersResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_role_members", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	guildID := data.GuildID.ValueString()
+	roleID := data.RoleID.ValueString()
+
+	if guildID == "" || roleID == "" {
+		resp.Diagnostics.AddError(
+			"Missing Required Fields",
+			"The guild_id and role_id are required to read the role members.",
+		)
+		return
+	}
+
+	mode, err := roleMembersMode(data.Mode)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Mode",
+			err.Error(),
+		)
+		return
+	}
+
+	// The member list cannot tell a deleted role apart from an empty one, so check the role first
+	roles, err := r.client.GuildRoles(guildID, discordgo.WithContext(ctx))
+	if err != nil {
+		if isDiscordNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Guild Not Found",
+				fmt.Sprintf("Guild %s was not found, so role %s no longer exists. Removing from state.", guildID, roleID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Fetching Roles",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles for guild %s", guildID), err),
+		)
+		return
+	}
+
+	roleExists := false
+	for _, role := range roles {
+		if role.ID == roleID {
+			roleExists = true
+			break
+		}
+	}
+	if !roleExists {
+		resp.Diagnostics.AddWarning(
+			"Role Not Found",
+			fmt.Sprintf("Role %s was not found in guild %s. It may have been deleted outside of Terraform. Removing from state.", roleID, guildID),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	holders, err := roleHolders(ctx, r.client, guildID, roleID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Role Members",
+			discordMembersErrorDetail(fmt.Sprintf("Unable to list members of role %s in guild %s", roleID, guildID), err),
+		)
+		return
+	}
+
+	// In authoritative mode every holder is tracked so extra holders show up as removals in the plan.
+	// In additive mode only the managed users that still hold the role are tracked.
+	tracked := holders
+	if mode == roleMembersModeAdditive {
+		managed, diags := stringsFromSet(ctx, data.UserIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		lost := stringSetDifference(managed, holders)
+		tracked = stringSetDifference(managed, lost)
+	}
+
+	userIDs, diags := stringsToSet(tracked)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", guildID, roleID))
+	data.UserIDs = userIDs
+	data.Mode = types.StringValue(mode)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *roleMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state roleMembersResourceModel
+
+	// Read Terraform plan and state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_role_members", "update", state.ID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	guildID := plan.GuildID.ValueString()
+	roleID := plan.RoleID.ValueString()
+
+	mode, err := roleMembersMode(plan.Mode)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Mode",
+			err.Error(),
+		)
+		return
+	}
+
+	desired, diags := stringsFromSet(ctx, plan.UserIDs)
+	resp.Diagnostics.Append(diags...)
+	previous, diags := stringsFromSet(ctx, state.UserIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, guildID, roleID, mode, desired, previous, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", guildID, roleID))
+	plan.Mode = types.StringValue(mode)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *roleMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data roleMembersResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_role_members", "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	guildID := data.GuildID.ValueString()
+	roleID := data.RoleID.ValueString()
+
+	if guildID == "" || roleID == "" {
+		resp.Diagnostics.AddError(
+			"Missing Required Fields",
+			"The guild_id and role_id are required to delete the role members.",
+		)
+		return
+	}
+
+	userIDs, diags := stringsFromSet(ctx, data.UserIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the role from every user this resource manages. A user who has left the guild no longer holds it.
+	for _, userID := range userIDs {
+		err := r.client.GuildMemberRoleRemove(guildID, userID, roleID, discordgo.WithContext(ctx))
+		if err != nil && !isDiscordNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Removing User from Role",
+				discordErrorDetail(fmt.Sprintf("Unable to remove user %s from role %s in guild %s", userID, roleID, guildID), err),
+			)
+		}
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *roleMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_role_members", "import", types.StringValue(req.ID))
+
+	// Import format: guild_id:role_id
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID Format",
+			"The import ID must be in the format 'guild_id:role_id' (e.g., '123456789012345678:987654321098765432').",
+		)
+		return
+	}
+
+	// Imported role member sets are authoritative; Read populates user_ids with the current holders
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), types.StringValue(parts[0]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), types.StringValue(parts[1]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mode"), types.StringValue(roleMembersModeAuthoritative))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+}
+
+// reconcile adds the role to desired users that lack it and removes it from users that should not hold it.
+// In authoritative mode every current holder outside desired is removed. In additive mode only users that
+// were previously managed (previous) and are no longer desired are removed.
+func (r *roleMembersResource) reconcile(ctx context.Context, guildID, roleID, mode string, desired, previous []string, diags *diag.Diagnostics) {
+	holders, err := roleHolders(ctx, r.client, guildID, roleID)
+	if err != nil {
+		diags.AddError(
+			"Error Fetching Role Members",
+			discordMembersErrorDetail(fmt.Sprintf("Unable to list members of role %s in guild %s", roleID, guildID), err),
+		)
+		return
+	}
+
+	toRemove := stringSetDifference(previous, desired)
+	if mode == roleMembersModeAuthoritative {
+		toRemove = stringSetDifference(holders, desired)
+	}
+
+	for _, userID := range stringSetDifference(desired, holders) {
+		err := r.client.GuildMemberRoleAdd(guildID, userID, roleID, discordgo.WithContext(ctx))
+		if err != nil {
+			diags.AddError(
+				"Error Adding User to Role",
+				discordErrorDetail(fmt.Sprintf("Unable to add user %s to role %s in guild %s", userID, roleID, guildID), err),
+			)
+		}
+	}
+
+	// A user who has left the guild since the holders were listed no longer holds the role
+	for _, userID := range toRemove {
+		err := r.client.GuildMemberRoleRemove(guildID, userID, roleID, discordgo.WithContext(ctx))
+		if err != nil && !isDiscordNotFound(err) {
+			diags.AddError(
+				"Error Removing User from Role",
+				discordErrorDetail(fmt.Sprintf("Unable to remove user %s from role %s in guild %s", userID, roleID, guildID), err),
+			)
+		}
+	}
+}
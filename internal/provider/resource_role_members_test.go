@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tfstack/terraform-provider-discord/internal/fakediscord"
+)
+
+func TestRoleMembersResource_Metadata(t *testing.T) {
+	r := NewRoleMembersResource()
+	req := resource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_role_members", resp.TypeName)
+}
+
+func TestRoleMembersResource_Schema(t *testing.T) {
+	r := NewRoleMembersResource()
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(t.Context(), req, resp)
+
+	assert.NotNil(t, resp.Schema)
+	assert.Contains(t, resp.Schema.Description, "Manages the complete member set of a Discord role")
+
+	// Check required attributes
+	requiredAttrs := []string{"role_id", "user_ids"}
+	for _, attrName := range requiredAttrs {
+		attr, ok := resp.Schema.Attributes[attrName]
+		assert.True(t, ok, "Attribute %s should exist", attrName)
+		assert.True(t, attr.IsRequired(), "Attribute %s should be required", attrName)
+	}
+
+	// guild_id defaults to the provider's guild_id
+	guildIDAttr, ok := resp.Schema.Attributes["guild_id"]
+	assert.True(t, ok)
+	assert.True(t, guildIDAttr.IsOptional())
+	assert.True(t, guildIDAttr.IsComputed())
+
+	// Check optional attributes
+	modeAttr, ok := resp.Schema.Attributes["mode"]
+	assert.True(t, ok)
+	assert.True(t, modeAttr.IsOptional())
+
+	// mode defaults to authoritative at plan time, so the plan shows the effective mode
+	modeDefault := modeAttr.(schema.StringAttribute).Default
+	require.NotNil(t, modeDefault)
+	defaultResp := &defaults.StringResponse{}
+	modeDefault.DefaultString(t.Context(), defaults.StringRequest{}, defaultResp)
+	assert.Equal(t, types.StringValue(roleMembersModeAuthoritative), defaultResp.PlanValue)
+
+	// Check computed attributes
+	idAttr, ok := resp.Schema.Attributes["id"]
+	assert.True(t, ok)
+	assert.True(t, idAttr.IsComputed())
+}
+
+func TestRoleMembersResource_Configure(t *testing.T) {
+	tests := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
+			expectError:  false,
+		},
+		{
+			name:          "invalid provider data type",
+			providerData:  "invalid",
+			expectError:   true,
+			errorContains: "Unexpected Resource Configure Type",
+		},
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &roleMembersResource{}
+			req := resource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &resource.ConfigureResponse{}
+
+			r.Configure(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+func TestRoleMembersMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     types.String
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "null defaults to authoritative",
+			mode:     types.StringNull(),
+			expected: roleMembersModeAuthoritative,
+		},
+		{
+			name:     "unknown defaults to authoritative",
+			mode:     types.StringUnknown(),
+			expected: roleMembersModeAuthoritative,
+		},
+		{
+			name:     "additive",
+			mode:     types.StringValue("additive"),
+			expected: roleMembersModeAdditive,
+		},
+		{
+			name:    "invalid mode",
+			mode:    types.StringValue("exclusive"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mode, err := roleMembersMode(tt.mode)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, mode)
+		})
+	}
+}
+
+func TestStringSetDifference(t *testing.T) {
+	assert.Equal(t, []string{"1", "3"}, stringSetDifference([]string{"3", "2", "1"}, []string{"2"}))
+	assert.Equal(t, []string{}, stringSetDifference([]string{"1"}, []string{"1", "2"}))
+	assert.Equal(t, []string{}, stringSetDifference(nil, []string{"1"}))
+}
+
+func TestAccRoleMembersResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	role := s.AddRole(guild.ID, "Members")
+	alice := s.AddUser("alice")
+	bob := s.AddUser("bob")
+	carol := s.AddUser("carol")
+	s.AddMember(guild.ID, alice)
+	s.AddMember(guild.ID, bob)
+	s.AddMember(guild.ID, carol, role.ID)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				// Authoritative mode takes the role away from holders that are not listed
+				Config: providerConfig + testAccRoleMembersResourceConfig(guild.ID, role.ID, alice.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_role_members.test", "user_ids.#", "1"),
+					tfresource.TestCheckResourceAttr("discord_role_members.test", "mode", roleMembersModeAuthoritative),
+					testAccCheckMemberHasRole(s, guild.ID, alice.ID, role.ID, true),
+					testAccCheckMemberHasRole(s, guild.ID, carol.ID, role.ID, false),
+				),
+			},
+			{
+				Config: providerConfig + testAccRoleMembersResourceConfig(guild.ID, role.ID, alice.ID, bob.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_role_members.test", "user_ids.#", "2"),
+					testAccCheckMemberHasRole(s, guild.ID, bob.ID, role.ID, true),
+				),
+			},
+			{
+				ResourceName:      "discord_role_members.test",
+				ImportState:       true,
+				ImportStateId:     guild.ID + ":" + role.ID,
+				ImportStateVerify: true,
+			},
+			{
+				// A holder removed outside Terraform gets the role back
+				PreConfig: func() { s.RemoveMemberRole(guild.ID, bob.ID, role.ID) },
+				Config:    providerConfig + testAccRoleMembersResourceConfig(guild.ID, role.ID, alice.ID, bob.ID),
+				Check:     testAccCheckMemberHasRole(s, guild.ID, bob.ID, role.ID, true),
+			},
+		},
+	})
+}
+
+func TestRoleMembersResource_ModifyPlanWarnsAboutRemovalsOnCreate(t *testing.T) {
+	s, _ := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	role := s.AddRole(guild.ID, "Members")
+	alice := s.AddUser("alice")
+	carol := s.AddUser("carol")
+	s.AddMember(guild.ID, alice, role.ID)
+	s.AddMember(guild.ID, carol, role.ID)
+
+	r := &roleMembersResource{client: testFakeClient(t, s)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(t.Context(), resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(t.Context()), nil)}
+	userIDs, diags := stringsToSet([]string{alice.ID})
+	require.False(t, diags.HasError())
+	require.False(t, plan.Set(t.Context(), &roleMembersResourceModel{
+		ID:       types.StringUnknown(),
+		GuildID:  types.StringValue(guild.ID),
+		RoleID:   types.StringValue(role.ID),
+		UserIDs:  userIDs,
+		Mode:     types.StringUnknown(),
+		Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType, "read": types.StringType, "update": types.StringType, "delete": types.StringType})},
+	}).HasError())
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   plan,
+		State:  tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(t.Context(), req, resp)
+
+	// Adopting the role in authoritative mode takes it away from carol, who is not listed
+	require.False(t, resp.Diagnostics.HasError())
+	require.Equal(t, 1, resp.Diagnostics.WarningsCount())
+	assert.Equal(t, "Users Will Be Removed From Role", resp.Diagnostics.Warnings()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), carol.ID)
+	assert.NotContains(t, resp.Diagnostics.Warnings()[0].Detail(), alice.ID)
+}
+
+func TestRoleMembersResource_DeparturesAreNotErrors(t *testing.T) {
+	s, _ := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	role := s.AddRole(guild.ID, "Members")
+	alice := s.AddUser("alice")
+	bob := s.AddUser("bob")
+	s.AddMember(guild.ID, alice, role.ID)
+	s.AddMember(guild.ID, bob, role.ID)
+	// bob leaves the guild after Terraform last saw him hold the role
+	s.RemoveMember(guild.ID, bob.ID)
+
+	r := &roleMembersResource{client: testFakeClient(t, s)}
+
+	t.Run("reconcile", func(t *testing.T) {
+		var diags diag.Diagnostics
+		r.reconcile(t.Context(), guild.ID, role.ID, roleMembersModeAdditive, []string{alice.ID}, []string{alice.ID, bob.ID}, &diags)
+		assert.False(t, diags.HasError(), "%v", diags)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(t.Context(), resource.SchemaRequest{}, schemaResp)
+		require.False(t, schemaResp.Diagnostics.HasError())
+
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(t.Context()), nil)}
+		userIDs, diags := stringsToSet([]string{alice.ID, bob.ID})
+		require.False(t, diags.HasError())
+		require.False(t, state.Set(t.Context(), &roleMembersResourceModel{
+			ID:       types.StringValue(guild.ID + ":" + role.ID),
+			GuildID:  types.StringValue(guild.ID),
+			RoleID:   types.StringValue(role.ID),
+			UserIDs:  userIDs,
+			Mode:     types.StringValue(roleMembersModeAuthoritative),
+			Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType, "read": types.StringType, "update": types.StringType, "delete": types.StringType})},
+		}).HasError())
+
+		resp := &resource.DeleteResponse{State: state}
+		r.Delete(t.Context(), resource.DeleteRequest{State: state}, resp)
+		assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		require.NoError(t, testAccCheckMemberHasRole(s, guild.ID, alice.ID, role.ID, false)(nil))
+	})
+}
+
+func testAccCheckMemberHasRole(s *fakediscord.Server, guildID, userID, roleID string, want bool) tfresource.TestCheckFunc {
+	return func(*terraform.State) error {
+		member, ok := s.Member(guildID, userID)
+		if !ok {
+			return fmt.Errorf("member %s not found", userID)
+		}
+		has := false
+		for _, id := range member.Roles {
+			has = has || id == roleID
+		}
+		if has != want {
+			return fmt.Errorf("member %s has role %s: %t, want %t", userID, roleID, has, want)
+		}
+		return nil
+	}
+}
+
+func testAccRoleMembersResourceConfig(guildID, roleID string, userIDs ...string) string {
+	return fmt.Sprintf(`
+resource "discord_role_members" "test" {
+  guild_id = %q
+  role_id  = %q
+  user_ids = %s
+}
+`, guildID, roleID, testAccStringList(userIDs))
+}
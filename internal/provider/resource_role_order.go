@@ -0,0 +1,473 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the resource type implements the required interfaces.
+var _ resource.Resource = &roleOrderResource{}
+var _ resource.ResourceWithConfigure = &roleOrderResource{}
+var _ resource.ResourceWithModifyPlan = &roleOrderResource{}
+var _ resource.ResourceWithImportState = &roleOrderResource{}
+
+// roleOrderResource defines the resource implementation.
+type roleOrderResource struct {
+	client *discordgo.Session
+	// defaultGuildID is the provider's guild_id, used when guild_id is not set.
+	defaultGuildID types.String
+}
+
+// roleOrderResourceModel describes the resource data model.
+type roleOrderResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	GuildID  types.String   `tfsdk:"guild_id"`
+	RoleIDs  types.List     `tfsdk:"role_ids"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewRoleOrderResource is a helper function to simplify testing.
+func NewRoleOrderResource() resource.Resource {
+	return &roleOrderResource{}
+}
+
+// rolesByPosition returns the roles sorted from the top of the hierarchy to the bottom.
+// Roles with the same position are ordered by ID, matching how Discord displays them.
+func rolesByPosition(roles []*discordgo.Role) []*discordgo.Role {
+	sorted := make([]*discordgo.Role, len(roles))
+	copy(sorted, roles)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Position != sorted[j].Position {
+			return sorted[i].Position > sorted[j].Position
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
+
+// reorderRoles assigns the positions currently held by the listed roles to those roles in the desired order
+// (highest first). Roles that are not listed keep their positions, so they stay pinned between their neighbours.
+// Discord allows roles to share a position, so tied slots are spread upwards to give every listed role its own
+// position; otherwise tied roles would read back in ID order rather than the desired one.
+// It returns only the roles whose position changes.
+func reorderRoles(roles []*discordgo.Role, desired []string) ([]*discordgo.Role, error) {
+	rolesByID := make(map[string]*discordgo.Role, len(roles))
+	for _, role := range roles {
+		rolesByID[role.ID] = role
+	}
+
+	seen := make(map[string]struct{}, len(desired))
+	slots := make([]int, 0, len(desired))
+	for _, roleID := range desired {
+		role, ok := rolesByID[roleID]
+		if !ok {
+			return nil, fmt.Errorf("role %s does not exist in the guild", roleID)
+		}
+		if _, dup := seen[roleID]; dup {
+			return nil, fmt.Errorf("role %s is listed more than once", roleID)
+		}
+		seen[roleID] = struct{}{}
+		slots = append(slots, role.Position)
+	}
+
+	// Highest slot goes to the first role in the list
+	sort.Sort(sort.Reverse(sort.IntSlice(slots)))
+	for i := len(slots) - 2; i >= 0; i-- {
+		if slots[i] <= slots[i+1] {
+			slots[i] = slots[i+1] + 1
+		}
+	}
+
+	changed := make([]*discordgo.Role, 0)
+	for i, roleID := range desired {
+		role := rolesByID[roleID]
+		if role.Position == slots[i] {
+			continue
+		}
+		moved := *role
+		moved.Position = slots[i]
+		changed = append(changed, &moved)
+	}
+
+	return changed, nil
+}
+
+// Metadata returns the resource type name.
+func (r *roleOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_order"
+}
+
+// Schema defines the schema for the resource.
+func (r *roleOrderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the relative order of roles in a Discord guild (server) hierarchy in a single reorder request. " +
+			"Roles that are not listed keep their place between their neighbours. Use this instead of setting positions on individual roles.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the role order (same as guild_id).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild (server) whose roles are ordered. Changing this forces a new resource. Defaults to the provider's guild_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
+			},
+			"role_ids": schema.ListAttribute{
+				Description: "The IDs of the roles in the desired order, from the top of the hierarchy to the bottom. " +
+					"Every role must be below the bot's highest role. The @everyone role cannot be listed.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators:  snowflakeListValidators(),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up the resource with the provider's configured client.
+func (r *roleOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*discordProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.defaultGuildID = providerData.guildID
+}
+
+// ModifyPlan fills in guild_id from the provider's default guild when it is not configured.
+func (r *roleOrderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanGuildID(ctx, r.defaultGuildID, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *roleOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data roleOrderResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_role_order", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logResourceCreated(ctx, data.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *roleOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data roleOrderResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_role_order", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	guildID := data.GuildID.ValueString()
+	if guildID == "" {
+		resp.Diagnostics.AddError(
+			"Missing Guild ID",
+			"The guild ID is missing from the state.",
+		)
+		return
+	}
+
+	roles, err := r.client.GuildRoles(guildID, discordgo.WithContext(ctx))
+	if err != nil {
+		if isDiscordNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Guild Not Found",
+				fmt.Sprintf("Guild %s was not found, so its role order is no longer managed. Removing from state.", guildID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Fetching Roles",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles for guild %s", guildID), err),
+		)
+		return
+	}
+
+	// Only the relative order of the listed roles is tracked. After import nothing is listed yet,
+	// so every role except @everyone is tracked.
+	tracked := make(map[string]struct{})
+	trackAll := data.RoleIDs.IsNull() || data.RoleIDs.IsUnknown()
+	if !trackAll {
+		var listed []string
+		resp.Diagnostics.Append(data.RoleIDs.ElementsAs(ctx, &listed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, roleID := range listed {
+			tracked[roleID] = struct{}{}
+		}
+	}
+
+	ordered := make([]attr.Value, 0)
+	for _, role := range rolesByPosition(roles) {
+		if role.ID == guildID {
+			continue
+		}
+		if _, ok := tracked[role.ID]; trackAll || ok {
+			ordered = append(ordered, types.StringValue(role.ID))
+		}
+	}
+
+	roleIDs, diags := types.ListValue(types.StringType, ordered)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(guildID)
+	data.RoleIDs = roleIDs
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *roleOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data roleOrderResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_role_order", "update", data.ID)
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Ensure client is configured
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Discord Client Not Configured",
+			"The Discord client was not properly configured. This is a provider error.",
+		)
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from Terraform state. The roles keep their current order.
+func (r *roleOrderResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Role order cannot be "deleted"; removing the resource simply stops managing it
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *roleOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_role_order", "import", types.StringValue(req.ID))
+
+	// The import ID is the guild ID; Read populates role_ids with every role in the current order
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"The import ID must be the guild ID.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), types.StringValue(req.ID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+}
+
+// apply reorders the guild's roles to match data.RoleIDs in a single GuildRoleReorder call.
+func (r *roleOrderResource) apply(ctx context.Context, data *roleOrderResourceModel, diags *diag.Diagnostics) {
+	guildID := data.GuildID.ValueString()
+	if guildID == "" {
+		diags.AddError(
+			"Missing Guild ID",
+			"The guild_id attribute is required.",
+		)
+		return
+	}
+
+	var desired []string
+	diags.Append(data.RoleIDs.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	for _, roleID := range desired {
+		if roleID == guildID {
+			diags.AddError(
+				"Cannot Order @everyone Role",
+				"The @everyone role is always at the bottom of the hierarchy and cannot be listed in role_ids.",
+			)
+			return
+		}
+	}
+
+	roles, err := r.client.GuildRoles(guildID, discordgo.WithContext(ctx))
+	if err != nil {
+		diags.AddError(
+			"Error Fetching Roles",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch roles for guild %s", guildID), err),
+		)
+		return
+	}
+
+	changed, err := reorderRoles(roles, desired)
+	if err != nil {
+		diags.AddError(
+			"Invalid Role Order",
+			fmt.Sprintf("Unable to order roles in guild %s: %s", guildID, err.Error()),
+		)
+		return
+	}
+
+	if len(changed) > 0 {
+		// A bot can only move roles that are below its own highest role, both before and after the move
+		highest, err := botHighestRole(ctx, r.client, guildID, roles)
+		if err != nil {
+			diags.AddError(
+				"Error Fetching Bot Role",
+				discordErrorDetail(fmt.Sprintf("Unable to determine the bot's highest role in guild %s", guildID), err),
+			)
+			return
+		}
+
+		rolesByID := make(map[string]*discordgo.Role, len(roles))
+		for _, role := range roles {
+			rolesByID[role.ID] = role
+		}
+
+		blocked := make([]string, 0)
+		for _, moved := range changed {
+			original := rolesByID[moved.ID]
+			if highest == nil || original.Position >= highest.Position || moved.Position >= highest.Position {
+				blocked = append(blocked, fmt.Sprintf("%s (%s)", original.Name, original.ID))
+			}
+		}
+
+		if len(blocked) > 0 {
+			botRole := "the bot has no roles"
+			if highest != nil {
+				botRole = fmt.Sprintf("the bot's highest role is %s at position %d", highest.Name, highest.Position)
+			}
+			diags.AddError(
+				"Role Order Blocked by Bot's Highest Role",
+				fmt.Sprintf("Unable to move %s in guild %s because %s. "+
+					"A bot can only move roles that are below its own highest role, and cannot move a role to or above it. "+
+					"Move the bot's role higher in Server Settings → Roles, or remove these roles from role_ids.",
+					strings.Join(blocked, ", "), guildID, botRole),
+			)
+			return
+		}
+
+		_, err = r.client.GuildRoleReorder(guildID, changed, discordgo.WithContext(ctx))
+		if err != nil {
+			diags.AddError(
+				"Error Reordering Roles",
+				discordErrorDetail(fmt.Sprintf("Unable to reorder roles in guild %s", guildID), err),
+			)
+			return
+		}
+	}
+
+	data.ID = types.StringValue(guildID)
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/tfstack/terraform-provider-discord/internal/fakediscord"
+)
+
+func TestRoleOrderResource_Metadata(t *testing.T) {
+	r := NewRoleOrderResource()
+	req := resource.MetadataRequest{
+		ProviderTypeName: "discord",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(t.Context(), req, resp)
+
+	assert.Equal(t, "discord_role_order", resp.TypeName)
+}
+
+func TestReorderRoles(t *testing.T) {
+	// Hierarchy from top to bottom: admin(5), mod(4), pinned(3), helper(2), member(1)
+	roles := []*discordgo.Role{
+		{ID: "member", Position: 1},
+		{ID: "helper", Position: 2},
+		{ID: "pinned", Position: 3},
+		{ID: "mod", Position: 4},
+		{ID: "admin", Position: 5},
+	}
+
+	positions := func(changed []*discordgo.Role) map[string]int {
+		out := make(map[string]int, len(changed))
+		for _, role := range changed {
+			out[role.ID] = role.Position
+		}
+		return out
+	}
+
+	t.Run("already ordered", func(t *testing.T) {
+		changed, err := reorderRoles(roles, []string{"admin", "mod", "helper"})
+		assert.NoError(t, err)
+		assert.Empty(t, changed)
+	})
+
+	t.Run("swap keeps unlisted role pinned", func(t *testing.T) {
+		changed, err := reorderRoles(roles, []string{"admin", "helper", "mod"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"helper": 4, "mod": 2}, positions(changed))
+	})
+
+	t.Run("tied positions are made distinct", func(t *testing.T) {
+		// Discord allows roles to share a position; ties would read back in ID order
+		tied := []*discordgo.Role{
+			{ID: "member", Position: 1},
+			{ID: "b", Position: 2},
+			{ID: "a", Position: 2},
+			{ID: "c", Position: 2},
+		}
+		changed, err := reorderRoles(tied, []string{"b", "c", "a"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"b": 4, "c": 3}, positions(changed))
+
+		// The result reads back in the desired order
+		for _, role := range changed {
+			for _, original := range tied {
+				if original.ID == role.ID {
+					original.Position = role.Position
+				}
+			}
+		}
+		order := make([]string, 0, len(tied))
+		for _, role := range rolesByPosition(tied) {
+			order = append(order, role.ID)
+		}
+		assert.Equal(t, []string{"b", "c", "a", "member"}, order)
+
+		changed, err = reorderRoles(tied, []string{"b", "c", "a"})
+		assert.NoError(t, err)
+		assert.Empty(t, changed)
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		_, err := reorderRoles(roles, []string{"admin", "missing"})
+		assert.Error(t, err)
+	})
+
+	t.Run("duplicate role", func(t *testing.T) {
+		_, err := reorderRoles(roles, []string{"admin", "admin"})
+		assert.Error(t, err)
+	})
+}
+
+func TestRolesByPosition(t *testing.T) {
+	roles := []*discordgo.Role{
+		{ID: "2", Position: 1},
+		{ID: "3", Position: 7},
+		{ID: "1", Position: 1},
+	}
+
+	sorted := rolesByPosition(roles)
+
+	assert.Equal(t, "3", sorted[0].ID)
+	assert.Equal(t, "1", sorted[1].ID)
+	assert.Equal(t, "2", sorted[2].ID)
+}
+
+func TestAccRoleOrderResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	members := s.AddRole(guild.ID, "Members")
+	moderators := s.AddRole(guild.ID, "Moderators")
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + testAccRoleOrderResourceConfig(guild.ID, moderators.ID, members.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_role_order.test", "id", guild.ID),
+					testAccCheckRoleAbove(s, guild.ID, moderators.ID, members.ID),
+				),
+			},
+			{
+				Config: providerConfig + testAccRoleOrderResourceConfig(guild.ID, members.ID, moderators.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_role_order.test", "role_ids.0", members.ID),
+					testAccCheckRoleAbove(s, guild.ID, members.ID, moderators.ID),
+				),
+			},
+			{
+				// Import reads every role, so only the guild is compared
+				ResourceName:            "discord_role_order.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"role_ids"},
+			},
+		},
+	})
+}
+
+func testAccCheckRoleAbove(s *fakediscord.Server, guildID, upperID, lowerID string) tfresource.TestCheckFunc {
+	return func(*terraform.State) error {
+		upper, _ := s.Role(guildID, upperID)
+		lower, _ := s.Role(guildID, lowerID)
+		if upper == nil || lower == nil || upper.Position <= lower.Position {
+			return fmt.Errorf("role %s is not above role %s", upperID, lowerID)
+		}
+		return nil
+	}
+}
+
+func testAccRoleOrderResourceConfig(guildID string, roleIDs ...string) string {
+	return fmt.Sprintf(`
+resource "discord_role_order" "test" {
+  guild_id = %q
+  role_ids = %s
+}
+`, guildID, testAccStringList(roleIDs))
+}
@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,7 +40,8 @@ func TestRoleResource_Schema(t *testing.T) {
 
 	guildIDAttr, ok := resp.Schema.Attributes["guild_id"]
 	assert.True(t, ok)
-	assert.True(t, guildIDAttr.IsRequired())
+	assert.True(t, guildIDAttr.IsOptional(), "guild_id defaults to the provider's guild_id")
+	assert.True(t, guildIDAttr.IsComputed())
 
 	// Check optional attributes
 	colorAttr, ok := resp.Schema.Attributes["color"]
@@ -58,8 +62,8 @@ func TestRoleResource_Configure(t *testing.T) {
 		errorContains string
 	}{
 		{
-			name:         "valid discordgo.Session",
-			providerData: &discordgo.Session{},
+			name:         "valid provider data",
+			providerData: &discordProviderData{client: &discordgo.Session{}},
 			expectError:  false,
 		},
 		{
@@ -96,3 +100,124 @@ func TestRoleResource_Configure(t *testing.T) {
 		})
 	}
 }
+
+func TestAccRoleResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+
+	var roleID string
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + testAccRoleResourceConfig(guild.ID, "Moderators", 3447003, true),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_role.test", "name", "Moderators"),
+					tfresource.TestCheckResourceAttr("discord_role.test", "color", "3447003"),
+					tfresource.TestCheckResourceAttr("discord_role.test", "hoist", "true"),
+					tfresource.TestCheckResourceAttr("discord_role.test", "managed", "false"),
+					testAccCaptureAttr("discord_role.test", "id", &roleID),
+				),
+			},
+			{
+				ResourceName:      "discord_role.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportID("discord_role.test", "guild_id", "id"),
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + testAccRoleResourceConfig(guild.ID, "Mods", 15158332, false),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_role.test", "name", "Mods"),
+					tfresource.TestCheckResourceAttr("discord_role.test", "color", "15158332"),
+					tfresource.TestCheckResourceAttr("discord_role.test", "hoist", "false"),
+					tfresource.TestCheckResourceAttrPtr("discord_role.test", "id", &roleID),
+				),
+			},
+			{
+				// A role deleted outside Terraform is removed from state and created again
+				PreConfig: func() { s.DeleteRole(guild.ID, roleID) },
+				Config:    providerConfig + testAccRoleResourceConfig(guild.ID, "Mods", 15158332, false),
+				Check: func(state *terraform.State) error {
+					id, err := testAccAttr(state, "discord_role.test", "id")
+					if err == nil && id == roleID {
+						return fmt.Errorf("role was not recreated after it was deleted")
+					}
+					return err
+				},
+			},
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, rs := range state.RootModule().Resources {
+				if _, ok := s.Role(guild.ID, rs.Primary.ID); ok {
+					return fmt.Errorf("role %s still exists", rs.Primary.ID)
+				}
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccRoleResource_DefaultGuild(t *testing.T) {
+	s, _ := testAccFakeDiscord(t)
+	first := s.AddGuild("First Guild")
+	second := s.AddGuild("Second Guild")
+
+	config := `
+resource "discord_role" "test" {
+  name = "Moderators"
+}
+
+data "discord_roles" "test" {
+  depends_on = [discord_role.test]
+}
+`
+
+	var roleID string
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfigWithGuild(s, first.ID) + config,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_role.test", "guild_id", first.ID),
+					tfresource.TestCheckResourceAttr("data.discord_roles.test", "guild_id", first.ID),
+					testAccCaptureAttr("discord_role.test", "id", &roleID),
+				),
+			},
+			{
+				// Setting the same guild explicitly is not a change
+				Config:   testAccProviderConfigWithGuild(s, first.ID) + testAccRoleResourceConfig(first.ID, "Moderators", 0, false),
+				PlanOnly: true,
+			},
+			{
+				// A role cannot move between guilds, so changing the default guild replaces it
+				Config: testAccProviderConfigWithGuild(s, second.ID) + config,
+				Check: func(state *terraform.State) error {
+					guildID, err := testAccAttr(state, "discord_role.test", "guild_id")
+					if err != nil {
+						return err
+					}
+					if guildID != second.ID {
+						return fmt.Errorf("expected guild_id %s, got %s", second.ID, guildID)
+					}
+					if _, ok := s.Role(first.ID, roleID); ok {
+						return fmt.Errorf("role %s was not removed from the first guild", roleID)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccRoleResourceConfig(guildID, name string, color int, hoist bool) string {
+	return fmt.Sprintf(`
+resource "discord_role" "test" {
+  guild_id = %q
+  name     = %q
+  color    = %d
+  hoist    = %t
+}
+`, guildID, name, color, hoist)
+}
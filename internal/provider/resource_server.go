@@ -5,8 +5,13 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -22,8 +27,9 @@ type serverResource struct {
 
 // serverResourceModel describes the resource data model.
 type serverResourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewServerResource is a helper function to simplify testing.
@@ -37,19 +43,33 @@ func (r *serverResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 // Schema defines the schema for the resource.
-func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *serverResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates and manages a Discord server (guild).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of the server (guild).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the server (guild). Must be 2-100 characters.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 100),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -59,16 +79,16 @@ func (r *serverResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -81,6 +101,17 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_server", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -111,20 +142,14 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Create the guild (server)
 	// Note: This endpoint requires a user OAuth2 token, not a bot token
 	// Bot tokens will receive error 20001: "Bots cannot use this endpoint"
-	guild, err := r.client.GuildCreate(name)
+	guild, err := r.client.GuildCreate(name, discordgo.WithContext(ctx))
 	if err != nil {
 		// Provide more helpful error message for bot token limitation
-		if err.Error() != "" {
-			resp.Diagnostics.AddError(
-				"Error Creating Server",
-				fmt.Sprintf("Unable to create server %s: %s\n\nNote: Creating servers requires a user OAuth2 token, not a bot token. Bot tokens cannot create servers.", name, err.Error()),
-			)
-		} else {
-			resp.Diagnostics.AddError(
-				"Error Creating Server",
-				fmt.Sprintf("Unable to create server %s. Note: Creating servers requires a user OAuth2 token, not a bot token. Bot tokens cannot create servers.", name),
-			)
-		}
+		resp.Diagnostics.AddError(
+			"Error Creating Server",
+			discordErrorDetail(fmt.Sprintf("Unable to create server %s", name), err)+
+				"\n\nNote: Creating servers requires a user OAuth2 token, not a bot token. Bot tokens cannot create servers.",
+		)
 		return
 	}
 
@@ -150,6 +175,8 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.ID = types.StringValue(guild.ID)
 	data.Name = types.StringValue(guild.Name)
 
+	logResourceCreated(ctx, data.ID)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -164,6 +191,17 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_server", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -183,11 +221,19 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Fetch the server by ID
-	guild, err := r.client.Guild(serverID)
+	guild, err := r.client.Guild(serverID, discordgo.WithContext(ctx))
 	if err != nil {
+		if isDiscordNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Server Not Found",
+				fmt.Sprintf("Server %s was not found. It may have been deleted outside of Terraform. Removing from state.", serverID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Fetching Server",
-			fmt.Sprintf("Unable to fetch server %s: %s", serverID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch server %s", serverID), err),
 		)
 		return
 	}
@@ -202,14 +248,26 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data serverResourceModel
+	var data, state serverResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = resourceLogContext(ctx, "discord_server", "update", state.ID)
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -219,7 +277,8 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	serverID := data.ID.ValueString()
+	// The ID is computed, so it is only known in the prior state
+	serverID := state.ID.ValueString()
 	if serverID == "" {
 		resp.Diagnostics.AddError(
 			"Missing Server ID",
@@ -251,11 +310,11 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Name: name,
 	}
 
-	guild, err := r.client.GuildEdit(serverID, guildParams)
+	guild, err := r.client.GuildEdit(serverID, guildParams, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Server",
-			fmt.Sprintf("Unable to update server %s: %s", serverID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to update server %s", serverID), err),
 		)
 		return
 	}
@@ -278,6 +337,17 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_server", "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -297,11 +367,11 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	// Delete the guild (server)
-	err := r.client.GuildDelete(serverID)
+	err := r.client.GuildDelete(serverID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Server",
-			fmt.Sprintf("Unable to delete server %s: %s", serverID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to delete server %s", serverID), err),
 		)
 		return
 	}
@@ -309,6 +379,8 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // ImportState imports an existing resource into Terraform state.
 func (r *serverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_server", "import", types.StringValue(req.ID))
+
 	// The import ID is the server ID
 	serverID := req.ID
 
@@ -322,17 +394,18 @@ func (r *serverResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 
 	// Fetch the server to populate state
-	guild, err := r.client.Guild(serverID)
+	guild, err := r.client.Guild(serverID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Server",
-			fmt.Sprintf("Unable to fetch server %s: %s", serverID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to fetch server %s", serverID), err),
 		)
 		return
 	}
 
 	// Create a model with the server data
 	var data serverResourceModel
+	data.Timeouts = nullTimeouts()
 	data.ID = types.StringValue(guild.ID)
 	data.Name = types.StringValue(guild.Name)
 
@@ -5,9 +5,15 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -19,19 +25,22 @@ var _ resource.ResourceWithImportState = &webhookResource{}
 // webhookResource defines the resource implementation.
 type webhookResource struct {
 	client *discordgo.Session
+	// apiURL is the provider's API base URL, under which webhook URLs are built.
+	apiURL string
 }
 
 // webhookResourceModel describes the resource data model.
 type webhookResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	ChannelID types.String `tfsdk:"channel_id"`
-	Name      types.String `tfsdk:"name"`
-	Avatar    types.String `tfsdk:"avatar"`
-	Token     types.String `tfsdk:"token"`
-	URL       types.String `tfsdk:"url"`
-	GuildID   types.String `tfsdk:"guild_id"`
-	User      types.String `tfsdk:"user"`
-	Type      types.Int64  `tfsdk:"type"`
+	ID        types.String   `tfsdk:"id"`
+	ChannelID types.String   `tfsdk:"channel_id"`
+	Name      types.String   `tfsdk:"name"`
+	Avatar    types.String   `tfsdk:"avatar"`
+	Token     types.String   `tfsdk:"token"`
+	URL       types.String   `tfsdk:"url"`
+	GuildID   types.String   `tfsdk:"guild_id"`
+	User      types.String   `tfsdk:"user"`
+	Type      types.Int64    `tfsdk:"type"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewWebhookResource is a helper function to simplify testing.
@@ -45,21 +54,34 @@ func (r *webhookResource) Metadata(_ context.Context, req resource.MetadataReque
 }
 
 // Schema defines the schema for the resource.
-func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *webhookResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates and manages a Discord webhook for a channel.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of the webhook.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"channel_id": schema.StringAttribute{
-				Description: "The ID of the channel to create the webhook for.",
+				Description: "The ID of the channel to create the webhook for. Changing this forces a new resource.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					snowflakeValidator(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the webhook. Must be 1-80 characters.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 80),
+					webhookNameValidator{},
+				},
 			},
 			"avatar": schema.StringAttribute{
 				Description: "The avatar hash of the webhook. Can be null if no avatar is set.",
@@ -70,24 +92,47 @@ func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "The token of the webhook (used for sending messages). This is sensitive and should be kept secret.",
 				Computed:    true,
 				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"url": schema.StringAttribute{
-				Description: "The full webhook URL (https://discord.com/api/webhooks/{id}/{token}).",
+				Description: "The full webhook URL ({api_url}/webhooks/{id}/{token}, by default https://discord.com/api/webhooks/{id}/{token}).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"guild_id": schema.StringAttribute{
 				Description: "The ID of the guild (server) this webhook belongs to.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"user": schema.StringAttribute{
 				Description: "The ID of the user who created the webhook.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"type": schema.Int64Attribute{
 				Description: "The type of the webhook (1 = Incoming, 2 = Channel Follower).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -97,16 +142,17 @@ func (r *webhookResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*discordgo.Session)
+	providerData, ok := req.ProviderData.(*discordProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.apiURL = providerData.apiURL
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -119,6 +165,17 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_webhook", "create", data.ID)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -162,11 +219,11 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Create the webhook
-	webhook, err := r.client.WebhookCreate(channelID, name, avatar)
+	webhook, err := r.client.WebhookCreate(channelID, name, avatar, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Webhook",
-			fmt.Sprintf("Unable to create webhook %s in channel %s: %s", name, channelID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to create webhook %s in channel %s", name, channelID), err),
 		)
 		return
 	}
@@ -215,7 +272,7 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Webhook URL
 	if webhook.ID != "" && webhook.Token != "" {
-		data.URL = types.StringValue(fmt.Sprintf("https://discord.com/api/webhooks/%s/%s", webhook.ID, webhook.Token))
+		data.URL = types.StringValue(webhookURL(r.apiURL, webhook.ID, webhook.Token))
 	} else {
 		data.URL = types.StringNull()
 	}
@@ -237,6 +294,8 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 	// Type
 	data.Type = types.Int64Value(int64(webhook.Type))
 
+	logResourceCreated(ctx, data.ID)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -251,6 +310,17 @@ func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_webhook", "read", data.ID)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -270,14 +340,21 @@ func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Fetch the webhook
-	webhook, err := r.client.Webhook(webhookID)
+	webhook, err := r.client.Webhook(webhookID, discordgo.WithContext(ctx))
 	if err != nil {
-		// If webhook doesn't exist, mark as removed
-		resp.Diagnostics.AddWarning(
-			"Webhook Not Found",
-			fmt.Sprintf("Webhook %s was not found. It may have been deleted. Removing from state.", webhookID),
+		if isDiscordNotFound(err) {
+			// If webhook doesn't exist, mark as removed
+			resp.Diagnostics.AddWarning(
+				"Webhook Not Found",
+				fmt.Sprintf("Webhook %s was not found. It may have been deleted. Removing from state.", webhookID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Fetching Webhook",
+			discordErrorDetail(fmt.Sprintf("Unable to fetch webhook %s", webhookID), err),
 		)
-		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -306,7 +383,7 @@ func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	// Webhook URL - can only be constructed if we have token
 	if !data.Token.IsNull() && !data.Token.IsUnknown() && data.Token.ValueString() != "" {
-		data.URL = types.StringValue(fmt.Sprintf("https://discord.com/api/webhooks/%s/%s", webhook.ID, data.Token.ValueString()))
+		data.URL = types.StringValue(webhookURL(r.apiURL, webhook.ID, data.Token.ValueString()))
 	} else {
 		data.URL = types.StringNull()
 	}
@@ -343,6 +420,17 @@ func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_webhook", "update", state.ID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -385,11 +473,11 @@ func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest
 	channelID := state.ChannelID.ValueString()
 
 	// Update the webhook
-	webhook, err := r.client.WebhookEdit(webhookID, name, avatar, channelID)
+	webhook, err := r.client.WebhookEdit(webhookID, name, avatar, channelID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Webhook",
-			fmt.Sprintf("Unable to update webhook %s: %s", webhookID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to update webhook %s", webhookID), err),
 		)
 		return
 	}
@@ -417,7 +505,7 @@ func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Webhook URL
 	if !data.Token.IsNull() && !data.Token.IsUnknown() && data.Token.ValueString() != "" {
-		data.URL = types.StringValue(fmt.Sprintf("https://discord.com/api/webhooks/%s/%s", webhook.ID, data.Token.ValueString()))
+		data.URL = types.StringValue(webhookURL(r.apiURL, webhook.ID, data.Token.ValueString()))
 	} else {
 		data.URL = types.StringNull()
 	}
@@ -453,6 +541,17 @@ func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = resourceLogContext(ctx, "discord_webhook", "delete", data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Ensure client is configured
 	if r.client == nil {
 		resp.Diagnostics.AddError(
@@ -472,11 +571,11 @@ func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	// Delete the webhook
-	err := r.client.WebhookDelete(webhookID)
+	err := r.client.WebhookDelete(webhookID, discordgo.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Webhook",
-			fmt.Sprintf("Unable to delete webhook %s: %s", webhookID, err.Error()),
+			discordErrorDetail(fmt.Sprintf("Unable to delete webhook %s", webhookID), err),
 		)
 		return
 	}
@@ -484,6 +583,8 @@ func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 // ImportState imports an existing resource into Terraform.
 func (r *webhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = resourceLogContext(ctx, "discord_webhook", "import", types.StringValue(req.ID))
+
 	// Import by webhook ID
 	webhookID := req.ID
 	if webhookID == "" {
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/tfstack/terraform-provider-discord/internal/fakediscord"
+)
+
+// testAccCheckWebhookURL returns a check that a webhook URL is built under the fake server's API URL.
+func testAccCheckWebhookURL(s *fakediscord.Server) func(string) error {
+	return func(value string) error {
+		if !strings.HasPrefix(value, s.URL+"/webhooks/") {
+			return fmt.Errorf("webhook URL %q is not under the configured api_url %s", value, s.URL)
+		}
+		return nil
+	}
+}
+
+func TestAccWebhookResource(t *testing.T) {
+	s, providerConfig := testAccFakeDiscord(t)
+	guild := s.AddGuild("Test Guild")
+	alerts := s.AddChannel(guild.ID, "alerts", discordgo.ChannelTypeGuildText, "")
+
+	var webhookID string
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig + testAccWebhookResourceConfig(alerts.ID, "Alerts"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_webhook.test", "name", "Alerts"),
+					tfresource.TestCheckResourceAttr("discord_webhook.test", "guild_id", guild.ID),
+					tfresource.TestCheckResourceAttrSet("discord_webhook.test", "token"),
+					tfresource.TestCheckResourceAttrWith("discord_webhook.test", "url", testAccCheckWebhookURL(s)),
+					testAccCaptureAttr("discord_webhook.test", "id", &webhookID),
+				),
+			},
+			{
+				// The token is only returned when the webhook is created
+				ResourceName:            "discord_webhook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token", "url"},
+			},
+			{
+				Config: providerConfig + testAccWebhookResourceConfig(alerts.ID, "Deploys"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("discord_webhook.test", "name", "Deploys"),
+					tfresource.TestCheckResourceAttrPtr("discord_webhook.test", "id", &webhookID),
+				),
+			},
+			{
+				// A webhook deleted outside Terraform is removed from state and created again
+				PreConfig: func() { s.DeleteWebhook(webhookID) },
+				Config:    providerConfig + testAccWebhookResourceConfig(alerts.ID, "Deploys"),
+				Check: func(state *terraform.State) error {
+					id, err := testAccAttr(state, "discord_webhook.test", "id")
+					if err == nil && id == webhookID {
+						return fmt.Errorf("webhook was not recreated after it was deleted")
+					}
+					return err
+				},
+			},
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, rs := range state.RootModule().Resources {
+				if _, ok := s.Webhook(rs.Primary.ID); ok {
+					return fmt.Errorf("webhook %s still exists", rs.Primary.ID)
+				}
+			}
+			return nil
+		},
+	})
+}
+
+func testAccWebhookResourceConfig(channelID, name string) string {
+	return fmt.Sprintf(`
+resource "discord_webhook" "test" {
+  channel_id = %q
+  name       = %q
+}
+`, channelID, name)
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultOperationTimeout bounds a resource create, read, update or delete whose timeout is not configured.
+const defaultOperationTimeout = 20 * time.Minute
+
+// timeoutsAttributeTypes are the attributes of the timeouts block every resource declares.
+var timeoutsAttributeTypes = map[string]attr.Type{
+	"create": types.StringType,
+	"read":   types.StringType,
+	"update": types.StringType,
+	"delete": types.StringType,
+}
+
+// nullTimeouts returns an unset timeouts block, for models that are not read from a plan or state, such as
+// on import.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(timeoutsAttributeTypes)}
+}
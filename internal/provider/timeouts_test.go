@@ -0,0 +1,16 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullTimeouts(t *testing.T) {
+	block := timeouts.Block(t.Context(), timeouts.Opts{Create: true, Read: true, Update: true, Delete: true})
+
+	value := nullTimeouts()
+	assert.True(t, value.IsNull())
+	assert.Equal(t, block.Type(), value.Type(t.Context()))
+}
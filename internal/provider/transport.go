@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultMaxRetries is the number of times a request is retried when max_retries is not set.
+	defaultMaxRetries = 3
+	// defaultMaxBackoff is the longest wait between retries when max_backoff is not set.
+	defaultMaxBackoff = 30 * time.Second
+	// minRetryBackoff is the first backoff step for retried 5xx responses and network errors.
+	minRetryBackoff = 500 * time.Millisecond
+)
+
+// rateLimitBucket tracks the state of one Discord rate limit bucket.
+type rateLimitBucket struct {
+	remaining int
+	resetAt   time.Time
+}
+
+// rateLimitTransport is an http.RoundTripper that schedules Discord API requests around the rate limits
+// reported in response headers, and retries 429 responses and idempotent 5xx responses with backoff.
+// A single transport is shared by every resource and data source, so the limits hold across the
+// goroutines Terraform runs in parallel.
+type rateLimitTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	maxBackoff time.Duration
+	// requestTimeout bounds each attempt on its own, so waits between retries do not count against it.
+	requestTimeout time.Duration
+	// token is masked in log output.
+	token string
+
+	mu sync.Mutex
+	// routes maps a route key to the bucket hash Discord reported for it.
+	routes map[string]string
+	// buckets maps a bucket hash (or route key before the hash is known) to its state.
+	buckets map[string]*rateLimitBucket
+	// globalResetAt is when the global rate limit lifts after a global 429.
+	globalResetAt time.Time
+}
+
+// newRateLimitTransport wraps base with rate limit scheduling and retries.
+func newRateLimitTransport(base http.RoundTripper, maxRetries int, maxBackoff time.Duration) *rateLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+
+	return &rateLimitTransport{
+		base:       base,
+		maxRetries: maxRetries,
+		maxBackoff: maxBackoff,
+		routes:     make(map[string]string),
+		buckets:    make(map[string]*rateLimitBucket),
+	}
+}
+
+// rateLimitRoute returns the key Discord uses to group a request into a bucket: the method and path, with
+// every ID except the top-level channel, guild or webhook ID replaced.
+func rateLimitRoute(method, path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	for i, part := range parts {
+		if part == "" || part[0] < '0' || part[0] > '9' {
+			continue
+		}
+		// Major parameters are kept because Discord tracks their limits separately
+		if i > 0 && (parts[i-1] == "channels" || parts[i-1] == "guilds" || parts[i-1] == "webhooks") && isMajorParameter(parts, i) {
+			continue
+		}
+		parts[i] = ":id"
+	}
+	return method + " /" + strings.Join(parts, "/")
+}
+
+// isMajorParameter reports whether the ID at index i is the first resource ID in the path.
+func isMajorParameter(parts []string, i int) bool {
+	for j := 0; j < i; j++ {
+		if parts[j] != "" && parts[j][0] >= '0' && parts[j][0] <= '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isIdempotentMethod reports whether a request with this method can be repeated safely.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether a 5xx status is worth retrying.
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// RoundTrip sends the request, waiting for the bucket and global limits and retrying where allowed.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	logCtx := apiLogContext(ctx, t.token)
+	route := rateLimitRoute(req.Method, req.URL.Path)
+
+	// Buffer the body so the request can be replayed
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if wait := t.waitFor(route); wait > 0 {
+			tflog.SubsystemDebug(logCtx, rateLimitLogSubsystem, "Waiting for Discord rate limit", map[string]interface{}{
+				"route":   routeTemplate(req.URL.Path),
+				"wait_ms": wait.Milliseconds(),
+			})
+			if exceedsDeadline(ctx, wait) {
+				return nil, fmt.Errorf("waiting %s for the Discord rate limit would exceed the operation timeout: %w", wait, context.DeadlineExceeded)
+			}
+			if err := sleepContext(ctx, wait); err != nil {
+				return nil, err
+			}
+		}
+
+		attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+		if t.requestTimeout > 0 {
+			attemptCtx, cancel = context.WithTimeout(ctx, t.requestTimeout)
+		}
+		attemptReq := req.Clone(attemptCtx)
+		if body != nil {
+			attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+			attemptReq.ContentLength = int64(len(body))
+		}
+
+		start := time.Now()
+		resp, err := t.base.RoundTrip(attemptReq)
+		logAPIRequest(logCtx, req, resp, err, attempt, time.Since(start))
+		if err != nil {
+			cancel()
+			if attempt >= t.maxRetries || !isIdempotentMethod(req.Method) || ctx.Err() != nil {
+				return nil, err
+			}
+			wait := t.backoff(attempt)
+			if exceedsDeadline(ctx, wait) {
+				return nil, err
+			}
+			logRetry(logCtx, req, attempt, wait, err.Error())
+			if err := sleepContext(ctx, wait); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// The attempt's timeout also covers reading the body, so it is released when the body is closed
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+		t.update(route, resp.Header)
+
+		var wait time.Duration
+		switch {
+		case resp.StatusCode == http.StatusTooManyRequests:
+			wait = t.retryAfter(route, resp)
+			// Give up rather than wait longer than allowed; discordgo reports the 429 to the caller
+			if attempt >= t.maxRetries || wait > t.maxBackoff || exceedsDeadline(ctx, wait) {
+				return resp, nil
+			}
+		case isRetryableStatus(resp.StatusCode) && isIdempotentMethod(req.Method):
+			wait = t.backoff(attempt)
+			if attempt >= t.maxRetries || exceedsDeadline(ctx, wait) {
+				return resp, nil
+			}
+		default:
+			return resp, nil
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		logRetry(logCtx, req, attempt, wait, resp.Status)
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// cancelOnCloseBody cancels the context of the attempt that produced a response once its body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the attempt's context.
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// waitFor returns how long to wait before a request on route may be sent, and reserves a slot in its bucket.
+func (t *rateLimitTransport) waitFor(route string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	var wait time.Duration
+	if t.globalResetAt.After(now) {
+		wait = t.globalResetAt.Sub(now)
+	}
+
+	bucket := t.bucketFor(route)
+	if bucket == nil {
+		return wait
+	}
+
+	if !bucket.resetAt.After(now) {
+		// The window has passed; the next response reports the new state
+		return wait
+	}
+
+	if bucket.remaining > 0 {
+		bucket.remaining--
+		return wait
+	}
+
+	if d := bucket.resetAt.Sub(now); d > wait {
+		wait = d
+	}
+	return wait
+}
+
+// bucketFor returns the known bucket for a route, or nil. The caller must hold t.mu.
+func (t *rateLimitTransport) bucketFor(route string) *rateLimitBucket {
+	key := route
+	if hash, ok := t.routes[route]; ok {
+		key = hash
+	}
+	return t.buckets[key]
+}
+
+// update records the rate limit headers of a response.
+func (t *rateLimitTransport) update(route string, header http.Header) {
+	remaining := header.Get("X-RateLimit-Remaining")
+	resetAfter := header.Get("X-RateLimit-Reset-After")
+	if remaining == "" || resetAfter == "" {
+		return
+	}
+
+	remainingValue, err := strconv.Atoi(remaining)
+	if err != nil {
+		return
+	}
+	resetSeconds, err := strconv.ParseFloat(resetAfter, 64)
+	if err != nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := route
+	if hash := header.Get("X-RateLimit-Bucket"); hash != "" {
+		t.routes[route] = hash
+		key = hash
+	}
+
+	t.buckets[key] = &rateLimitBucket{
+		remaining: remainingValue,
+		resetAt:   time.Now().Add(time.Duration(resetSeconds * float64(time.Second))),
+	}
+}
+
+// retryAfter returns how long Discord asked to wait after a 429 response, recording global limits.
+func (t *rateLimitTransport) retryAfter(route string, resp *http.Response) time.Duration {
+	seconds, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64)
+	global := resp.Header.Get("X-RateLimit-Global") == "true"
+
+	if err != nil {
+		// Fall back to the JSON body, which carries a more precise value
+		var payload struct {
+			RetryAfter float64 `json:"retry_after"`
+			Global     bool    `json:"global"`
+		}
+		if body, readErr := io.ReadAll(resp.Body); readErr == nil {
+			// Keep the original body as the closer, so closing the replay still ends the attempt's context
+			resp.Body = struct {
+				io.Reader
+				io.Closer
+			}{bytes.NewReader(body), resp.Body}
+			if json.Unmarshal(body, &payload) == nil {
+				seconds = payload.RetryAfter
+				global = global || payload.Global
+			}
+		}
+	}
+
+	wait := time.Duration(seconds * float64(time.Second))
+	if wait <= 0 {
+		wait = minRetryBackoff
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	resetAt := time.Now().Add(wait)
+	if global {
+		t.globalResetAt = resetAt
+	} else if bucket := t.bucketFor(route); bucket != nil {
+		bucket.remaining = 0
+		bucket.resetAt = resetAt
+	}
+
+	return wait
+}
+
+// backoff returns the jittered exponential backoff for a retry attempt, capped at maxBackoff.
+func (t *rateLimitTransport) backoff(attempt int) time.Duration {
+	d := minRetryBackoff << attempt
+	if d <= 0 || d > t.maxBackoff {
+		d = t.maxBackoff
+	}
+	// Wait between half and all of the step so parallel requests do not retry in lockstep
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// exceedsDeadline reports whether waiting d would pass the deadline of ctx, such as a resource timeout. A
+// retry that cannot start before the deadline is not attempted, so the last error is returned instead.
+func exceedsDeadline(ctx context.Context, d time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return ok && time.Now().Add(d).After(deadline)
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
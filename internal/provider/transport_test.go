@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitRoute(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{"GET", "/api/v9/channels/123", "GET /api/v9/channels/123"},
+		{"DELETE", "/api/v9/channels/123/messages/456", "DELETE /api/v9/channels/123/messages/:id"},
+		{"PUT", "/api/v9/guilds/1/members/2/roles/3", "PUT /api/v9/guilds/1/members/:id/roles/:id"},
+		{"GET", "/api/v9/users/@me", "GET /api/v9/users/@me"},
+		{"GET", "/api/v9/invites/abc", "GET /api/v9/invites/abc"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, rateLimitRoute(tt.method, tt.path))
+	}
+}
+
+func TestRateLimitTransport_Retries429(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"name":"test"}`, string(body))
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0.01")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(nil, 3, time.Second)}
+	resp, err := client.Post(server.URL+"/api/v9/guilds/1/roles", "application/json", strings.NewReader(`{"name":"test"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestRateLimitTransport_GivesUpWhenRetryAfterExceedsMaxBackoff(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(nil, 3, time.Second)}
+	resp, err := client.Get(server.URL + "/api/v9/channels/1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRateLimitTransport_GivesUpWhenRetryAfterExceedsDeadline(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v9/channels/1", nil)
+	require.NoError(t, err)
+
+	client := &http.Client{Transport: newRateLimitTransport(nil, 3, time.Minute)}
+	start := time.Now()
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestRateLimitTransport_Retries5xxOnlyForIdempotentMethods(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(nil, 3, 10*time.Millisecond)}
+
+	resp, err := client.Get(server.URL + "/api/v9/channels/1")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	atomic.StoreInt32(&calls, 0)
+	resp, err = client.Post(server.URL+"/api/v9/channels/1/messages", "application/json", strings.NewReader("{}"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRateLimitTransport_WaitsForExhaustedBucket(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Bucket", "abc")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset-After", "0.2")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(nil, 3, time.Second)}
+
+	resp, err := client.Get(server.URL + "/api/v9/channels/1")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	start := time.Now()
+	resp, err = client.Get(server.URL + "/api/v9/channels/1")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+}
+
+func TestRateLimitTransport_Backoff(t *testing.T) {
+	transport := newRateLimitTransport(nil, 3, 2*time.Second)
+
+	for attempt := 0; attempt < 10; attempt++ {
+		d := transport.backoff(attempt)
+		assert.LessOrEqual(t, d, 2*time.Second)
+		assert.GreaterOrEqual(t, d, minRetryBackoff/2)
+	}
+}
+
+// contextRecordingTransport sends requests with http.DefaultTransport and records the context of each one.
+type contextRecordingTransport struct {
+	contexts []context.Context
+}
+
+func (t *contextRecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.contexts = append(t.contexts, req.Context())
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRateLimitTransport_ReleasesAttemptContextOfReturned429(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		// No Retry-After header, so the wait is read from the body
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = io.WriteString(w, `{"message":"You are being rate limited.","retry_after":60,"global":false}`)
+	}))
+	defer server.Close()
+
+	base := &contextRecordingTransport{}
+	transport := newRateLimitTransport(base, 3, time.Second)
+	transport.requestTimeout = time.Minute
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL + "/api/v9/channels/1")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	// The 429 is returned with its body intact, and closing it ends the attempt's timeout context
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Contains(t, string(body), `"retry_after":60`)
+	require.Len(t, base.contexts, 1)
+	assert.ErrorIs(t, base.contexts[0].Err(), context.Canceled)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	// maxColor is the largest RGB color Discord accepts for a role.
+	maxColor = 0xFFFFFF
+	// maxInviteAge is the longest max_age Discord accepts for an invite, 7 days in seconds.
+	maxInviteAge = 604800
+	// maxInviteUses is the largest max_uses Discord accepts for an invite.
+	maxInviteUses = 100
+)
+
+// snowflakeRegexp matches a Discord snowflake ID.
+var snowflakeRegexp = regexp.MustCompile(`^[0-9]{17,20}$`)
+
+// emojiNameRegexp matches the names Discord accepts for custom emojis.
+var emojiNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_]{2,32}$`)
+
+// snowflakeValidator validates that a string is a Discord snowflake ID.
+func snowflakeValidator() validator.String {
+	return stringvalidator.RegexMatches(snowflakeRegexp, "must be a Discord ID (a snowflake of 17 to 20 digits)")
+}
+
+// snowflakeListValidators validate that a list holds unique Discord snowflake IDs.
+func snowflakeListValidators() []validator.List {
+	return []validator.List{
+		listvalidator.UniqueValues(),
+		listvalidator.ValueStringsAre(snowflakeValidator()),
+	}
+}
+
+// snowflakeSetValidator validates that every element of a set is a Discord snowflake ID.
+func snowflakeSetValidator() validator.Set {
+	return setvalidator.ValueStringsAre(snowflakeValidator())
+}
+
+// webhookNameValidator rejects webhook names Discord refuses: names containing "clyde" or "discord".
+type webhookNameValidator struct{}
+
+var _ validator.String = webhookNameValidator{}
+
+func (v webhookNameValidator) Description(_ context.Context) string {
+	return `must not contain "clyde" or "discord"`
+}
+
+func (v webhookNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v webhookNameValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	name := strings.ToLower(req.ConfigValue.ValueString())
+	for _, reserved := range []string{"clyde", "discord"} {
+		if strings.Contains(name, reserved) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Webhook Name",
+				fmt.Sprintf("Discord does not allow webhook names that contain %q, got %q.", reserved, req.ConfigValue.ValueString()),
+			)
+			return
+		}
+	}
+}
+
+// textChannelName returns the name Discord stores for a text channel named name: lowercase, with spaces
+// replaced by hyphens.
+func textChannelName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(name), " ", "-")
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnowflakeValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "snowflake", value: types.StringValue("123456789012345678")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "too short", value: types.StringValue("123"), wantErr: true},
+		{name: "not a number", value: types.StringValue("general"), wantErr: true},
+		{name: "mention", value: types.StringValue("<@123456789012345678>"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("guild_id"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			snowflakeValidator().ValidateString(t.Context(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestWebhookNameValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "allowed", value: types.StringValue("Deploy Bot")},
+		{name: "null", value: types.StringNull()},
+		{name: "contains discord", value: types.StringValue("My Discord Hook"), wantErr: true},
+		{name: "contains clyde", value: types.StringValue("clydebot"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("name"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			webhookNameValidator{}.ValidateString(t.Context(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestTextChannelName(t *testing.T) {
+	assert.Equal(t, "general", textChannelName("general"))
+	assert.Equal(t, "general-chat", textChannelName("General Chat"))
+	assert.Equal(t, "off-topic", textChannelName("off-topic"))
+}